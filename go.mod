@@ -1,3 +1,10 @@
 module fish_eco_sim
 
 go 1.24.3
+
+require (
+	github.com/google/flatbuffers v25.12.19+incompatible
+	google.golang.org/protobuf v1.36.12
+)
+
+require github.com/klauspost/compress v1.18.0
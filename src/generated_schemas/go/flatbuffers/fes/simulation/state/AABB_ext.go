@@ -0,0 +1,38 @@
+// Hand-written extensions to the generated AABB accessor. This file is not
+// produced by flatc, so regenerating the schema will not overwrite it.
+
+package state
+
+// Contains reports whether p lies inside the box.
+//
+// Boxes are half-open: the min edge is inside, the max edge is not. A point on
+// the shared edge of two adjacent boxes therefore belongs to exactly one of
+// them, so boundary fish are never assigned twice.
+//
+// A box is expected to satisfy min <= max on both axes. If that invariant is
+// violated on either axis the box is treated as empty: Contains and
+// Intersects always return false for it. A box with min == max on an axis is
+// empty for the same reason.
+func (rcv *AABB) Contains(p *Vec2f) bool {
+	var lo, hi Vec2f
+	rcv.Min(&lo)
+	rcv.Max(&hi)
+	x, y := p.X(), p.Y()
+	return x >= lo.X() && x < hi.X() && y >= lo.Y() && y < hi.Y()
+}
+
+// Intersects reports whether the box overlaps other by a non-zero area. Boxes
+// that only share an edge or a corner do not intersect. See Contains for the
+// min <= max invariant.
+func (rcv *AABB) Intersects(other *AABB) bool {
+	var aLo, aHi, bLo, bHi Vec2f
+	rcv.Min(&aLo)
+	rcv.Max(&aHi)
+	other.Min(&bLo)
+	other.Max(&bHi)
+	if aLo.X() >= aHi.X() || aLo.Y() >= aHi.Y() || bLo.X() >= bHi.X() || bLo.Y() >= bHi.Y() {
+		return false
+	}
+	return aLo.X() < bHi.X() && bLo.X() < aHi.X() &&
+		aLo.Y() < bHi.Y() && bLo.Y() < aHi.Y()
+}
@@ -0,0 +1,76 @@
+package state
+
+import (
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// newAABB returns an AABB accessor over a freshly built buffer.
+func newAABB(minX, minY, maxX, maxY float32) *AABB {
+	b := flatbuffers.NewBuilder(0)
+	b.Finish(CreateAABB(b, minX, minY, maxX, maxY))
+	buf := b.FinishedBytes()
+	box := &AABB{}
+	box.Init(buf, flatbuffers.GetUOffsetT(buf))
+	return box
+}
+
+func TestCreateAABBLayout(t *testing.T) {
+	box := newAABB(-1, -2, 3, 4)
+	lo, hi := box.Min(nil), box.Max(nil)
+	if lo.X() != -1 || lo.Y() != -2 || hi.X() != 3 || hi.Y() != 4 {
+		t.Fatalf("got min=(%v, %v) max=(%v, %v)", lo.X(), lo.Y(), hi.X(), hi.Y())
+	}
+}
+
+func TestAABBContains(t *testing.T) {
+	box := newAABB(0, 0, 10, 10)
+	tests := []struct {
+		x, y float32
+		want bool
+	}{
+		{5, 5, true},
+		{0, 0, true},
+		{0, 9.99, true},
+		{10, 5, false},
+		{5, 10, false},
+		{-0.01, 5, false},
+	}
+	for _, tt := range tests {
+		if got := box.Contains(newVec2f(tt.x, tt.y)); got != tt.want {
+			t.Errorf("Contains(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	if newAABB(10, 0, 0, 10).Contains(newVec2f(5, 5)) {
+		t.Error("inverted box must be empty")
+	}
+}
+
+func TestAABBIntersects(t *testing.T) {
+	a := newAABB(0, 0, 10, 10)
+	tests := []struct {
+		name  string
+		other *AABB
+		want  bool
+	}{
+		{"overlap", newAABB(5, 5, 15, 15), true},
+		{"contained", newAABB(2, 2, 3, 3), true},
+		{"shared edge", newAABB(10, 0, 20, 10), false},
+		{"shared corner", newAABB(10, 10, 20, 20), false},
+		{"disjoint", newAABB(11, 11, 20, 20), false},
+		{"inverted", newAABB(8, 8, 2, 2), false},
+		{"degenerate", newAABB(5, 5, 5, 5), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.Intersects(tt.other); got != tt.want {
+				t.Errorf("a.Intersects = %v, want %v", got, tt.want)
+			}
+			if got := tt.other.Intersects(a); got != tt.want {
+				t.Errorf("other.Intersects = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
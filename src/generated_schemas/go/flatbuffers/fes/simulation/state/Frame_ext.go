@@ -0,0 +1,35 @@
+// Hand-written extensions to the generated Frame accessor. This file is not
+// produced by flatc, so regenerating the schema will not overwrite it.
+
+package state
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// EntityKind discriminates the tables an Entity union can hold. It is the
+// generated union type tag under a name that reads better at call sites.
+type EntityKind = Entity
+
+// EntityAt returns the kind and table of the i-th entity in the frame.
+// Consumers switch on kind and Init the matching accessor over table:
+//
+//	switch kind, tab := f.EntityAt(i); kind {
+//	case EntityFishState:
+//		var fish FishState
+//		fish.Init(tab.Bytes, tab.Pos)
+//	default:
+//		// Unknown or future kind: skip it.
+//	}
+//
+// A kind this build does not know about (one added to the schema later) is
+// returned as-is, never reinterpreted as a known table, so the default case
+// skips it safely. An entry with no entity set, or an i outside
+// [0, EntitiesLength()), returns EntityNONE.
+func (rcv *Frame) EntityAt(i int) (kind EntityKind, table flatbuffers.Table) {
+	var e FrameEntity
+	if i < 0 || i >= rcv.EntitiesLength() || !rcv.Entities(&e, i) || !e.Entity(&table) {
+		return EntityNONE, table
+	}
+	return e.EntityType(), table
+}
@@ -0,0 +1,27 @@
+// Hand-written extensions to the generated Species enum. This file is not
+// produced by flatc, so regenerating the schema will not overwrite it.
+//
+// Species values are persisted in recordings, so the numeric value of an
+// existing species must never change. When a species is retired, delete it
+// from the schema but never hand its value to a new species; old buffers
+// would silently decode as the newcomer. The generated String method covers
+// the name direction; ParseSpecies is its inverse.
+
+package state
+
+import "strings"
+
+// ParseSpecies returns the species named s, as produced by Species.String.
+// Matching is case-insensitive so config files can use "guppy" or "Guppy".
+// Unrecognised names return (SpeciesUnknown, false).
+func ParseSpecies(s string) (Species, bool) {
+	if v, ok := EnumValuesSpecies[s]; ok {
+		return v, true
+	}
+	for name, v := range EnumValuesSpecies {
+		if strings.EqualFold(name, s) {
+			return v, true
+		}
+	}
+	return SpeciesUnknown, false
+}
@@ -0,0 +1,55 @@
+package state
+
+import "testing"
+
+// TestSpeciesWireValues pins the numeric value of every species. If this
+// fails, a species was renumbered or reused; that breaks every recording made
+// before the change. Add new species with fresh values instead.
+func TestSpeciesWireValues(t *testing.T) {
+	want := map[Species]string{
+		0: "Unknown",
+		1: "Guppy",
+		2: "Pike",
+		3: "Angelfish",
+		4: "Catfish",
+	}
+	if len(EnumNamesSpecies) != len(want) {
+		t.Errorf("schema defines %d species, test pins %d; pin the new value here", len(EnumNamesSpecies), len(want))
+	}
+	for v, name := range want {
+		if got := v.String(); got != name {
+			t.Errorf("Species(%d).String() = %q, want %q", v, got, name)
+		}
+	}
+}
+
+func TestParseSpecies(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Species
+		ok   bool
+	}{
+		{"Pike", SpeciesPike, true},
+		{"guppy", SpeciesGuppy, true},
+		{"CATFISH", SpeciesCatfish, true},
+		{"Unknown", SpeciesUnknown, true},
+		{"shark", SpeciesUnknown, false},
+		{"", SpeciesUnknown, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseSpecies(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseSpecies(%q) = (%v, %v), want (%v, %v)", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	for v := range EnumNamesSpecies {
+		if got, ok := ParseSpecies(v.String()); !ok || got != v {
+			t.Errorf("ParseSpecies(%q) = (%v, %v), want (%v, true)", v.String(), got, ok, v)
+		}
+	}
+
+	if got := Species(42).String(); got != "Species(42)" {
+		t.Errorf("String of unnamed value = %q", got)
+	}
+}
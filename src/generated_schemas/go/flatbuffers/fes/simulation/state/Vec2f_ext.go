@@ -0,0 +1,158 @@
+// Hand-written extensions to the generated Vec2f accessor. This file is not
+// produced by flatc, so regenerating the schema will not overwrite it.
+
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"strconv"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Vec2fSize is the inline size of a Vec2f struct, and therefore the stride
+// between consecutive elements of a [Vec2f] vector.
+const Vec2fSize = 8
+
+// ApproxEqual reports whether rcv and other differ by less than epsilon in
+// both components. An epsilon of zero or less falls back to exact equality.
+//
+// Two nil accessors are equal; a nil and a non-nil accessor are not.
+func (rcv *Vec2f) ApproxEqual(other *Vec2f, epsilon float32) bool {
+	if rcv == nil || other == nil {
+		return rcv == nil && other == nil
+	}
+	if epsilon <= 0 {
+		return rcv.X() == other.X() && rcv.Y() == other.Y()
+	}
+	return absFloat32(rcv.X()-other.X()) < epsilon && absFloat32(rcv.Y()-other.Y()) < epsilon
+}
+
+func absFloat32(f float32) float32 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// MutateXY sets both components in place and reports whether both writes
+// succeeded.
+//
+// The generated mutators index the buffer directly and panic rather than
+// return false when the struct runs past its end, so the bounds check is done
+// here up front. A truncated buffer therefore yields false with neither
+// component written, instead of a half-updated position.
+func (rcv *Vec2f) MutateXY(x, y float32) bool {
+	if int(rcv._tab.Pos)+Vec2fSize > len(rcv._tab.Bytes) {
+		return false
+	}
+	okX := rcv.MutateX(x)
+	okY := rcv.MutateY(y)
+	return okX && okY
+}
+
+// ReadVec2fSlice iterates count Vec2f structs laid out back to back in buf,
+// starting at vectorOffset (the first element, as returned by Table.Vector).
+//
+// Elements are assumed to be packed at a stride of Vec2fSize (8) bytes, which
+// holds for any FlatBuffers vector of Vec2f. Each yielded Vec2f is an accessor
+// positioned in place over buf; no component data is copied and nothing is
+// allocated per element. It stays valid only as long as buf is unchanged. If
+// buf is too short for count elements, only the elements that fit are yielded.
+func ReadVec2fSlice(buf []byte, vectorOffset flatbuffers.UOffsetT, count int) iter.Seq2[int, Vec2f] {
+	if avail := (len(buf) - int(vectorOffset)) / Vec2fSize; count > avail {
+		count = max(avail, 0)
+	}
+	return func(yield func(int, Vec2f) bool) {
+		var v Vec2f
+		for i := 0; i < count; i++ {
+			v.Init(buf, vectorOffset+flatbuffers.UOffsetT(i*Vec2fSize))
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// ErrNoBuffer is returned by UnmarshalJSON when the Vec2f is not positioned
+// over a writable buffer large enough to hold it.
+var ErrNoBuffer = errors.New("state: Vec2f has no backing buffer to write into")
+
+// MarshalJSON encodes the vector as {"x":...,"y":...} for debugging tools.
+// Components are written in plain decimal with the fewest digits that round
+// trip through float32, and always with a fractional part, so -2 is written
+// as -2.0 and consumers that infer types from the text see a float.
+//
+// JSON has no representation for NaN or ±Inf, so such components are written
+// as null rather than failing the whole encode. A nil accessor encodes as null.
+func (rcv *Vec2f) MarshalJSON() ([]byte, error) {
+	if rcv == nil {
+		return []byte("null"), nil
+	}
+	out := make([]byte, 0, 32)
+	out = append(out, `{"x":`...)
+	out = appendJSONFloat32(out, rcv.X())
+	out = append(out, `,"y":`...)
+	out = appendJSONFloat32(out, rcv.Y())
+	out = append(out, '}')
+	return out, nil
+}
+
+// UnmarshalJSON decodes {"x":...,"y":...} and writes the components into the
+// existing buffer through the mutators; it never allocates a new buffer.
+//
+// Both fields are required. A null component (as MarshalJSON emits for NaN or
+// Inf) or a value outside the float32 range is rejected, and the buffer is
+// left unchanged on any error.
+func (rcv *Vec2f) UnmarshalJSON(data []byte) error {
+	if int(rcv._tab.Pos)+Vec2fSize > len(rcv._tab.Bytes) {
+		return ErrNoBuffer
+	}
+	var raw struct {
+		X *float64 `json:"x"`
+		Y *float64 `json:"y"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("state: decoding Vec2f: %w", err)
+	}
+	x, err := jsonComponent("x", raw.X)
+	if err != nil {
+		return err
+	}
+	y, err := jsonComponent("y", raw.Y)
+	if err != nil {
+		return err
+	}
+	if !rcv.MutateXY(x, y) {
+		return ErrNoBuffer
+	}
+	return nil
+}
+
+func appendJSONFloat32(out []byte, f float32) []byte {
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return append(out, "null"...)
+	}
+	start := len(out)
+	out = strconv.AppendFloat(out, float64(f), 'f', -1, 32)
+	if !bytes.ContainsRune(out[start:], '.') {
+		out = append(out, ".0"...)
+	}
+	return out
+}
+
+func jsonComponent(name string, v *float64) (float32, error) {
+	if v == nil {
+		return 0, fmt.Errorf("state: decoding Vec2f: %q is missing or null (NaN and Inf are not representable)", name)
+	}
+	f := float32(*v)
+	if math.IsInf(float64(f), 0) {
+		return 0, fmt.Errorf("state: decoding Vec2f: %q = %v overflows float32", name, *v)
+	}
+	return f, nil
+}
@@ -0,0 +1,206 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// newVec2f returns a Vec2f accessor over a standalone 8-byte buffer.
+func newVec2f(x, y float32) *Vec2f {
+	buf := make([]byte, 8)
+	flatbuffers.WriteFloat32(buf[0:], x)
+	flatbuffers.WriteFloat32(buf[4:], y)
+	v := &Vec2f{}
+	v.Init(buf, 0)
+	return v
+}
+
+func TestVec2fApproxEqual(t *testing.T) {
+	const eps = 1e-6
+
+	tests := []struct {
+		name    string
+		a, b    *Vec2f
+		epsilon float32
+		want    bool
+	}{
+		{"identical", newVec2f(1, 2), newVec2f(1, 2), eps, true},
+		{"within epsilon", newVec2f(0, 0), newVec2f(0.9e-6, -0.9e-6), eps, true},
+		{"exactly epsilon", newVec2f(0, 0), newVec2f(1e-6, 0), eps, false},
+		{"beyond epsilon x", newVec2f(0, 0), newVec2f(1.1e-6, 0), eps, false},
+		{"beyond epsilon y", newVec2f(0, 0), newVec2f(0, -1.1e-6), eps, false},
+		{"zero epsilon equal", newVec2f(0.1, 0.2), newVec2f(0.1, 0.2), 0, true},
+		{"zero epsilon tiny diff", newVec2f(0, 0), newVec2f(1e-30, 0), 0, false},
+		{"both nil", nil, nil, eps, true},
+		{"left nil", nil, newVec2f(0, 0), eps, false},
+		{"right nil", newVec2f(0, 0), nil, eps, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.ApproxEqual(tt.b, tt.epsilon); got != tt.want {
+				t.Errorf("ApproxEqual = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVec2fMutateXY(t *testing.T) {
+	v := newVec2f(1, 2)
+	if !v.MutateXY(-3.5, 4.25) {
+		t.Fatal("MutateXY returned false on a valid buffer")
+	}
+	if v.X() != -3.5 || v.Y() != 4.25 {
+		t.Fatalf("got (%v, %v), want (-3.5, 4.25)", v.X(), v.Y())
+	}
+}
+
+func TestVec2fMutateXYTruncated(t *testing.T) {
+	// Room for X but not Y: neither component may be written.
+	buf := make([]byte, 6)
+	flatbuffers.WriteFloat32(buf, 7)
+	v := &Vec2f{}
+	v.Init(buf, 0)
+
+	if v.MutateXY(1, 2) {
+		t.Fatal("MutateXY returned true on a truncated buffer")
+	}
+	if got := flatbuffers.GetFloat32(buf); got != 7 {
+		t.Fatalf("X was partially written: got %v, want 7", got)
+	}
+
+	empty := &Vec2f{}
+	if empty.MutateXY(1, 2) {
+		t.Fatal("MutateXY returned true on a nil buffer")
+	}
+}
+
+// buildVec2fVector finishes a buffer whose root is a vector of n Vec2f structs
+// with element i at (i, -i), and returns the buffer and the first element's
+// offset.
+func buildVec2fVector(n int) ([]byte, flatbuffers.UOffsetT) {
+	b := flatbuffers.NewBuilder(n*Vec2fSize + 16)
+	b.StartVector(Vec2fSize, n, 4)
+	for i := n - 1; i >= 0; i-- {
+		CreateVec2f(b, float32(i), float32(-i))
+	}
+	b.Finish(b.EndVector(n))
+	buf := b.FinishedBytes()
+	vec := flatbuffers.GetUOffsetT(buf)
+	return buf, vec + flatbuffers.SizeUOffsetT
+}
+
+func TestReadVec2fSlice(t *testing.T) {
+	buf, start := buildVec2fVector(10)
+
+	n := 0
+	for i, v := range ReadVec2fSlice(buf, start, 10) {
+		if i != n {
+			t.Fatalf("index = %d, want %d", i, n)
+		}
+		if v.X() != float32(i) || v.Y() != float32(-i) {
+			t.Fatalf("element %d = (%v, %v)", i, v.X(), v.Y())
+		}
+		n++
+	}
+	if n != 10 {
+		t.Fatalf("yielded %d elements, want 10", n)
+	}
+
+	for i := range ReadVec2fSlice(buf, start, 10) {
+		if i == 3 {
+			break
+		}
+	}
+
+	short := 0
+	for range ReadVec2fSlice(buf[:int(start)+3*Vec2fSize+4], start, 10) {
+		short++
+	}
+	if short != 3 {
+		t.Fatalf("truncated buffer yielded %d elements, want 3", short)
+	}
+}
+
+func TestReadVec2fSliceZeroAllocs(t *testing.T) {
+	buf, start := buildVec2fVector(5000)
+	var sum float32
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, v := range ReadVec2fSlice(buf, start, 5000) {
+			sum += v.X()
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("iterating 5000 elements allocated %v times, want 0", allocs)
+	}
+}
+
+func BenchmarkReadVec2fSlice(b *testing.B) {
+	buf, start := buildVec2fVector(5000)
+	b.ReportAllocs()
+	var sum float32
+	for i := 0; i < b.N; i++ {
+		for _, v := range ReadVec2fSlice(buf, start, 5000) {
+			sum += v.X() + v.Y()
+		}
+	}
+}
+
+func TestVec2fMarshalJSON(t *testing.T) {
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(1))
+	tests := []struct {
+		v    *Vec2f
+		want string
+	}{
+		{newVec2f(1.5, -2), `{"x":1.5,"y":-2.0}`},
+		{newVec2f(0.1, 0), `{"x":0.1,"y":0.0}`},
+		{newVec2f(1e6, 1e-7), `{"x":1000000.0,"y":0.0000001}`},
+		{newVec2f(float32(math.Copysign(0, -1)), 16777216), `{"x":-0.0,"y":16777216.0}`},
+		{newVec2f(nan, 3), `{"x":null,"y":3.0}`},
+		{newVec2f(-inf, inf), `{"x":null,"y":null}`},
+		{nil, `null`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.v)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Marshal = %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestVec2fUnmarshalJSON(t *testing.T) {
+	v := newVec2f(0, 0)
+	if err := json.Unmarshal([]byte(`{"x":1.5,"y":-2.0}`), v); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if v.X() != 1.5 || v.Y() != -2 {
+		t.Fatalf("got (%v, %v), want (1.5, -2)", v.X(), v.Y())
+	}
+
+	for _, in := range []string{
+		`{"x":null,"y":1}`,
+		`{"x":1}`,
+		`{"x":1e39,"y":0}`,
+		`{"x":"1","y":0}`,
+		`[1,2]`,
+	} {
+		if err := json.Unmarshal([]byte(in), v); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded, want error", in)
+		}
+		if v.X() != 1.5 || v.Y() != -2 {
+			t.Errorf("Unmarshal(%s) modified the buffer: (%v, %v)", in, v.X(), v.Y())
+		}
+	}
+
+	if err := (&Vec2f{}).UnmarshalJSON([]byte(`{"x":1,"y":2}`)); !errors.Is(err, ErrNoBuffer) {
+		t.Errorf("Unmarshal into nil buffer: err = %v, want ErrNoBuffer", err)
+	}
+}
@@ -0,0 +1,180 @@
+// Package delta encodes the difference between consecutive frames so that a
+// stream only carries entities that moved, appeared, or disappeared.
+//
+// Only positions are tracked for surviving entities; their other fields, such
+// as a fish's energy, are carried over from the previous frame unchanged.
+package delta
+
+import (
+	"slices"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// Options controls how Encode decides what to include in a delta.
+type Options struct {
+	// Scale is the quantization scale passed to vecmath.Quantize.
+	Scale float32
+	// Threshold is how many quantization steps an entity's quantized position
+	// may change by, on each axis, before the move is included. It is judged
+	// on quantized values, not world distance, so with a zero Threshold every
+	// change of step is sent and Apply reproduces curr's quantized positions
+	// exactly, whatever prev is.
+	//
+	// A non-zero Threshold keeps the receiver within Threshold steps of the
+	// truth only if the prev passed to Encode is the frame the receiver holds,
+	// that is the previous Apply result. Given the true previous frame
+	// instead, moves under the threshold are never sent and the receiver
+	// drifts without bound.
+	Threshold int
+}
+
+// DefaultOptions is used by the package-level Encode. Positions are quantized
+// to 1/8 of a world unit and every change of step is sent.
+var DefaultOptions = Options{Scale: 8}
+
+// Move is a quantized change in an existing entity's position.
+type Move struct {
+	ID uint32
+	// DX and DY are the difference between the quantized current and previous
+	// positions. They use wrapping int16 arithmetic, so adding them back to
+	// the quantized previous position recovers the current one exactly even
+	// when the subtraction overflowed.
+	DX, DY int16
+}
+
+// Spawn is an entity absent from the previous frame, at its quantized
+// absolute position.
+type Spawn struct {
+	ID   uint32
+	X, Y int16
+	// Entity holds the new entity's kind and remaining fields. Its position
+	// is ignored in favour of X and Y.
+	Entity frame.EntityArgs
+}
+
+// FrameDelta is the difference between two frames.
+type FrameDelta struct {
+	Tick        uint64
+	TimestampNs int64
+	// Bounds is the world extents of the current frame, carried whole since
+	// it is small and can change between any two ticks.
+	Bounds vecmath.AABB
+	// Obstacles are those of the current frame, likewise carried whole:
+	// they change rarely and are few.
+	Obstacles []frame.ObstacleArgs
+	Scale     float32
+	// Full is set when there was no previous frame; every entity is then in
+	// Added and Apply ignores its prev argument.
+	Full    bool
+	Moved   []Move
+	Added   []Spawn
+	Removed []uint32
+}
+
+// Encode returns the delta from prev to curr using DefaultOptions. A nil prev
+// produces a full snapshot.
+func Encode(prev, curr *state.Frame) *FrameDelta {
+	return DefaultOptions.Encode(prev, curr)
+}
+
+// Encode returns the delta from prev to curr. A nil prev produces a full
+// snapshot.
+func (o Options) Encode(prev, curr *state.Frame) *FrameDelta {
+	d := &FrameDelta{
+		Tick:        curr.Tick(),
+		TimestampNs: curr.TimestampNs(),
+		Bounds:      vecmath.AABBFromFB(curr.Bounds(nil)),
+		Obstacles:   slices.Collect(frame.Obstacles(curr)),
+		Scale:       o.Scale,
+		Full:        prev == nil,
+	}
+
+	var before map[uint32]vecmath.Vec2f
+	if prev != nil {
+		before = frame.Positions(prev)
+	}
+
+	seen := make(map[uint32]struct{}, curr.EntitiesLength())
+	for e := range frame.Entities(curr) {
+		id, pos := e.ID(), e.Position()
+		seen[id] = struct{}{}
+
+		old, ok := before[id]
+		if !ok {
+			x, y := vecmath.Quantize(pos, o.Scale)
+			d.Added = append(d.Added, Spawn{ID: id, X: x, Y: y, Entity: e})
+			continue
+		}
+		ox, oy := vecmath.Quantize(old, o.Scale)
+		nx, ny := vecmath.Quantize(pos, o.Scale)
+		if max(absDiff(nx, ox), absDiff(ny, oy)) <= max(o.Threshold, 0) {
+			continue
+		}
+		d.Moved = append(d.Moved, Move{ID: id, DX: nx - ox, DY: ny - oy})
+	}
+
+	if prev != nil {
+		for e := range frame.Entities(prev) {
+			if _, ok := seen[e.ID()]; !ok {
+				d.Removed = append(d.Removed, e.ID())
+			}
+		}
+	}
+	return d
+}
+
+// Apply reconstructs the frame that d was encoded against, starting from prev.
+//
+// Moved and added entities are placed at the centre of their quantization
+// step, so re-quantizing them yields exactly the values Encode saw. Entities
+// whose quantized move was within the encoder's threshold keep their position
+// from prev.
+// Surviving entities keep their order from prev, followed by additions.
+// Entities of kinds this build does not know are dropped on both sides.
+func Apply(prev *state.Frame, d *FrameDelta) *state.Frame {
+	removed := make(map[uint32]struct{}, len(d.Removed))
+	for _, id := range d.Removed {
+		removed[id] = struct{}{}
+	}
+	moved := make(map[uint32]Move, len(d.Moved))
+	for _, m := range d.Moved {
+		moved[m.ID] = m
+	}
+
+	fb := frame.FrameBuilder{Tick: d.Tick, TimestampNs: d.TimestampNs, Bounds: d.Bounds, Obstacles: d.Obstacles}
+	if prev != nil && !d.Full {
+		for e := range frame.Entities(prev) {
+			if _, ok := removed[e.ID()]; ok {
+				continue
+			}
+			if m, ok := moved[e.ID()]; ok {
+				x, y := vecmath.Quantize(e.Position(), d.Scale)
+				e.SetPosition(vecmath.Dequantize(x+m.DX, y+m.DY, d.Scale))
+			}
+			fb.Entities = append(fb.Entities, e)
+		}
+	}
+	for _, s := range d.Added {
+		e := s.Entity
+		e.SetPosition(vecmath.Dequantize(s.X, s.Y, d.Scale))
+		fb.Entities = append(fb.Entities, e)
+	}
+
+	buf := fb.Finish(flatbuffers.NewBuilder(0))
+	return state.GetRootAsFrame(buf, 0)
+}
+
+// absDiff is |a-b| without int16 wraparound, so a move across the whole
+// range is never mistaken for a small one.
+func absDiff(a, b int16) int {
+	d := int(a) - int(b)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
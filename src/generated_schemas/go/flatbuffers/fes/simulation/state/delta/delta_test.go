@@ -0,0 +1,210 @@
+package delta
+
+import (
+	"slices"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func buildFrame(tick uint64, entities ...frame.EntityArgs) *state.Frame {
+	fb := frame.FrameBuilder{Tick: tick, TimestampNs: int64(tick) * 1000, Entities: entities}
+	return state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+}
+
+func fish(id uint32, x, y float32) frame.EntityArgs {
+	return frame.Fish(frame.FishStateArgs{ID: id, Position: vecmath.Vec2f{X: x, Y: y}})
+}
+
+type quantized struct{ x, y int16 }
+
+func quantizedPositions(f *state.Frame, scale float32) map[uint32]quantized {
+	out := make(map[uint32]quantized)
+	for e := range frame.Entities(f) {
+		x, y := vecmath.Quantize(e.Position(), scale)
+		out[e.ID()] = quantized{x, y}
+	}
+	return out
+}
+
+func assertReconstructs(t *testing.T, prev, curr *state.Frame, opts Options) *FrameDelta {
+	t.Helper()
+	d := opts.Encode(prev, curr)
+	got := Apply(prev, d)
+
+	if got.Tick() != curr.Tick() || got.TimestampNs() != curr.TimestampNs() {
+		t.Errorf("tick/timestamp = %d/%d, want %d/%d", got.Tick(), got.TimestampNs(), curr.Tick(), curr.TimestampNs())
+	}
+	if gb, cb := vecmath.AABBFromFB(got.Bounds(nil)), vecmath.AABBFromFB(curr.Bounds(nil)); gb != cb {
+		t.Errorf("bounds = %+v, want %+v", gb, cb)
+	}
+	if gobs, cobs := slices.Collect(frame.Obstacles(got)), slices.Collect(frame.Obstacles(curr)); !slices.Equal(gobs, cobs) {
+		t.Errorf("obstacles = %+v, want %+v", gobs, cobs)
+	}
+	want := quantizedPositions(curr, opts.Scale)
+	have := quantizedPositions(got, opts.Scale)
+	if len(have) != len(want) {
+		t.Fatalf("reconstructed %d entities, want %d", len(have), len(want))
+	}
+	for id, q := range want {
+		if have[id] != q {
+			t.Errorf("entity %d quantized to %v, want %v", id, have[id], q)
+		}
+	}
+	return d
+}
+
+func TestFirstFrameIsFullSnapshot(t *testing.T) {
+	curr := buildFrame(1, fish(1, 10, 10), fish(2, -5, 3.3))
+	d := assertReconstructs(t, nil, curr, DefaultOptions)
+	if !d.Full || len(d.Added) != 2 || len(d.Moved) != 0 || len(d.Removed) != 0 {
+		t.Fatalf("delta = %+v, want full snapshot of 2 additions", d)
+	}
+}
+
+func TestAddRemoveAndMove(t *testing.T) {
+	prev := buildFrame(1, fish(1, 10, 10), fish(2, 20, 20), fish(3, 30, 30))
+	curr := buildFrame(2, fish(1, 10.2, 10), fish(3, 35, 29), fish(4, -1, -1))
+
+	d := assertReconstructs(t, prev, curr, DefaultOptions)
+	if d.Full {
+		t.Error("delta against a previous frame must not be full")
+	}
+	if !slices.Equal(d.Removed, []uint32{2}) {
+		t.Errorf("Removed = %v, want [2]", d.Removed)
+	}
+	if len(d.Added) != 1 || d.Added[0].ID != 4 {
+		t.Errorf("Added = %+v, want entity 4", d.Added)
+	}
+	if len(d.Moved) != 2 {
+		t.Errorf("Moved = %+v, want entities 1 and 3", d.Moved)
+	}
+}
+
+func TestThresholdDropsSmallMoves(t *testing.T) {
+	prev := buildFrame(1, fish(1, 10, 10), fish(2, 20, 20))
+	curr := buildFrame(2, fish(1, 10.01, 10), fish(2, 25, 20))
+
+	// 10.01 stays in step 80, so it is not a move at all.
+	d := assertReconstructs(t, prev, curr, DefaultOptions)
+	if len(d.Moved) != 1 || d.Moved[0].ID != 2 {
+		t.Fatalf("Moved = %+v, want only entity 2", d.Moved)
+	}
+
+	// With a threshold of 2 steps, a 2-step move is dropped and a 3-step one
+	// is sent.
+	opts := Options{Scale: 8, Threshold: 2}
+	curr = buildFrame(2, fish(1, 10.25, 10), fish(2, 20, 20.375))
+	d = opts.Encode(prev, curr)
+	if len(d.Moved) != 1 || d.Moved[0] != (Move{ID: 2, DY: 3}) {
+		t.Fatalf("Moved = %+v, want only entity 2 by 3 steps", d.Moved)
+	}
+}
+
+func TestDefaultOptionsCrossStepBoundary(t *testing.T) {
+	// 10.06 and 10.12 are under 1/16 apart but quantize to steps 80 and 81.
+	prev := buildFrame(1, fish(1, 10.06, 5))
+	curr := buildFrame(2, fish(1, 10.12, 5))
+	d := assertReconstructs(t, prev, curr, DefaultOptions)
+	if len(d.Moved) != 1 || d.Moved[0] != (Move{ID: 1, DX: 1}) {
+		t.Fatalf("Moved = %+v, want entity 1 by one step", d.Moved)
+	}
+}
+
+func TestSmallMovesDoNotDrift(t *testing.T) {
+	const ticks, step = 100, 0.05
+	truth := buildFrame(0, fish(1, 10, 10))
+	recon := Apply(nil, Encode(nil, truth))
+
+	for tick := uint64(1); tick <= ticks; tick++ {
+		x := 10 + float32(tick)*step
+		curr := buildFrame(tick, fish(1, x, 10))
+		// Encode against the true previous frame, as a recorder would.
+		recon = Apply(recon, Encode(truth, curr))
+		truth = curr
+
+		want := quantizedPositions(curr, DefaultOptions.Scale)[1]
+		if got := quantizedPositions(recon, DefaultOptions.Scale)[1]; got != want {
+			t.Fatalf("tick %d: receiver at step %v, want %v", tick, got, want)
+		}
+	}
+}
+
+func TestThresholdChainStaysBounded(t *testing.T) {
+	// With a threshold, encoding against the receiver's own frame keeps its
+	// error within Threshold steps.
+	opts := Options{Scale: 8, Threshold: 3}
+	var recon *state.Frame
+	for tick := uint64(0); tick <= 100; tick++ {
+		curr := buildFrame(tick, fish(1, 10+float32(tick)*0.05, 10))
+		recon = Apply(recon, opts.Encode(recon, curr))
+
+		want := quantizedPositions(curr, opts.Scale)[1]
+		got := quantizedPositions(recon, opts.Scale)[1]
+		if absDiff(got.x, want.x) > opts.Threshold || got.y != want.y {
+			t.Fatalf("tick %d: receiver at step %v, want within %d of %v", tick, got, opts.Threshold, want)
+		}
+	}
+}
+
+func TestBoundsFollowCurrentFrame(t *testing.T) {
+	build := func(tick uint64, maxX float32) *state.Frame {
+		fb := frame.FrameBuilder{
+			Tick:     tick,
+			Bounds:   vecmath.AABB{Max: vecmath.Vec2f{X: maxX, Y: 100}},
+			Entities: []frame.EntityArgs{fish(1, 10, 10)},
+		}
+		return state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+	}
+	// The tank is resized between ticks while nothing moves.
+	assertReconstructs(t, build(1, 100), build(2, 250), DefaultOptions)
+}
+
+func TestObstaclesFollowCurrentFrame(t *testing.T) {
+	build := func(tick uint64, obstacles ...frame.ObstacleArgs) *state.Frame {
+		fb := frame.FrameBuilder{Tick: tick, Entities: []frame.EntityArgs{fish(1, 10, 10)}, Obstacles: obstacles}
+		return state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+	}
+	rock := frame.ObstacleArgs{ID: 1, Bounds: vecmath.AABB{Min: vecmath.Vec2f{X: 1, Y: 1}, Max: vecmath.Vec2f{X: 3, Y: 3}}}
+	wall := frame.ObstacleArgs{ID: 2, Bounds: vecmath.AABB{Min: vecmath.Vec2f{X: 50}, Max: vecmath.Vec2f{X: 50.5, Y: 100}}}
+	// A divider is added, then every obstacle is taken out.
+	assertReconstructs(t, build(1, rock), build(2, rock, wall), DefaultOptions)
+	assertReconstructs(t, build(2, rock, wall), build(3), DefaultOptions)
+}
+
+func TestMoveAcrossInt16Wraparound(t *testing.T) {
+	// Quantized positions at opposite ends of the int16 range: the int16
+	// difference overflows but must still reconstruct exactly.
+	opts := Options{Scale: 1}
+	prev := buildFrame(1, fish(1, -32000, 32000))
+	curr := buildFrame(2, fish(1, 32000, -32000))
+	assertReconstructs(t, prev, curr, opts)
+}
+
+func TestApplyChain(t *testing.T) {
+	frames := []*state.Frame{
+		buildFrame(1, fish(1, 0, 0), fish(2, 5, 5)),
+		buildFrame(2, fish(1, 1, 0.5), fish(2, 5, 5)),
+		buildFrame(3, fish(1, 2, 1), fish(3, 7, 7)),
+		buildFrame(4, fish(3, 8, 6.25)),
+	}
+	opts := Options{Scale: 8}
+	var recon *state.Frame
+	for _, f := range frames {
+		recon = Apply(recon, opts.Encode(recon, f))
+		want := quantizedPositions(f, opts.Scale)
+		have := quantizedPositions(recon, opts.Scale)
+		if len(have) != len(want) {
+			t.Fatalf("tick %d: %d entities, want %d", f.Tick(), len(have), len(want))
+		}
+		for id, q := range want {
+			if have[id] != q {
+				t.Fatalf("tick %d: entity %d = %v, want %v", f.Tick(), id, have[id], q)
+			}
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Package flow models environmental currents as a grid of force vectors that
+// push fish around the world.
+package flow
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// ErrMalformed is returned by FromFB for a FlowField table whose dimensions,
+// bounds and vectors do not agree.
+var ErrMalformed = errors.New("flow: malformed flow field")
+
+// FlowField is a cols x rows grid of force vectors spread evenly over a world
+// region. Node (0, 0) sits at bounds.Min and node (cols-1, rows-1) at
+// bounds.Max, so the outer nodes lie on the region's edges.
+type FlowField struct {
+	bounds     vecmath.AABB
+	cols, rows int
+	vectors    []vecmath.Vec2f // row-major
+}
+
+// NewFlowField returns a field of zero vectors over bounds. It panics if
+// bounds is empty or either dimension is less than 1. A dimension of 1 makes
+// the field constant along that axis.
+func NewFlowField(bounds vecmath.AABB, cols, rows int) *FlowField {
+	if bounds.Empty() {
+		panic("flow: bounds must not be empty")
+	}
+	if cols < 1 || rows < 1 {
+		panic("flow: field must have at least one node on each axis")
+	}
+	return &FlowField{bounds: bounds, cols: cols, rows: rows, vectors: make([]vecmath.Vec2f, cols*rows)}
+}
+
+// Bounds returns the region the field covers.
+func (f *FlowField) Bounds() vecmath.AABB {
+	return f.bounds
+}
+
+// Cols returns the number of nodes along X.
+func (f *FlowField) Cols() int {
+	return f.cols
+}
+
+// Rows returns the number of nodes along Y.
+func (f *FlowField) Rows() int {
+	return f.rows
+}
+
+// At returns the vector at node (i, j). It panics if the node is outside the
+// grid.
+func (f *FlowField) At(i, j int) vecmath.Vec2f {
+	return f.vectors[f.index(i, j)]
+}
+
+// Set sets the vector at node (i, j). It panics if the node is outside the
+// grid.
+func (f *FlowField) Set(i, j int, v vecmath.Vec2f) {
+	f.vectors[f.index(i, j)] = v
+}
+
+// NodePosition returns the world position of node (i, j).
+func (f *FlowField) NodePosition(i, j int) vecmath.Vec2f {
+	return vecmath.Vec2f{
+		X: nodeCoord(f.bounds.Min.X, f.bounds.Max.X, i, f.cols),
+		Y: nodeCoord(f.bounds.Min.Y, f.bounds.Max.Y, j, f.rows),
+	}
+}
+
+// Sample returns the force at pos, bilinearly interpolated between the four
+// surrounding nodes. Sampling exactly on a node returns that node's vector.
+// Positions outside the bounds are clamped onto the nearest edge, so the edge
+// nodes extend outward rather than the field wrapping around.
+func (f *FlowField) Sample(pos vecmath.Vec2f) vecmath.Vec2f {
+	i, tx := gridCoord(pos.X, f.bounds.Min.X, f.bounds.Max.X, f.cols)
+	j, ty := gridCoord(pos.Y, f.bounds.Min.Y, f.bounds.Max.Y, f.rows)
+	i1, j1 := min(i+1, f.cols-1), min(j+1, f.rows-1)
+
+	bottom := vecmath.Lerp(f.At(i, j), f.At(i1, j), tx)
+	top := vecmath.Lerp(f.At(i, j1), f.At(i1, j1), tx)
+	return vecmath.Lerp(bottom, top, ty)
+}
+
+// Build writes the field into builder as a state.FlowField table and returns
+// its offset.
+func (f *FlowField) Build(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	state.FlowFieldStartVectorsVector(builder, len(f.vectors))
+	for i := len(f.vectors) - 1; i >= 0; i-- {
+		vecmath.ToFB(builder, f.vectors[i])
+	}
+	vectors := builder.EndVector(len(f.vectors))
+
+	state.FlowFieldStart(builder)
+	state.FlowFieldAddBounds(builder, vecmath.AABBToFB(builder, f.bounds))
+	state.FlowFieldAddCols(builder, uint32(f.cols))
+	state.FlowFieldAddRows(builder, uint32(f.rows))
+	state.FlowFieldAddVectors(builder, vectors)
+	return state.FlowFieldEnd(builder)
+}
+
+// Finish builds the field as the root of builder and returns the finished
+// bytes. The returned slice aliases the builder's buffer.
+func (f *FlowField) Finish(builder *flatbuffers.Builder) []byte {
+	state.FinishFlowFieldBuffer(builder, f.Build(builder))
+	return builder.FinishedBytes()
+}
+
+// FromFB copies a FlowField table into a FlowField. It returns an error
+// wrapping ErrMalformed if the bounds are missing or empty, a dimension is
+// zero, or the vector count is not cols * rows. It does not bounds-check the
+// buffer itself, so ff must come from a trusted source.
+func FromFB(ff *state.FlowField) (*FlowField, error) {
+	bounds := vecmath.AABBFromFB(ff.Bounds(nil))
+	cols, rows := uint64(ff.Cols()), uint64(ff.Rows())
+	switch {
+	case bounds.Empty():
+		return nil, fmt.Errorf("%w: empty bounds %+v", ErrMalformed, bounds)
+	case cols == 0 || rows == 0:
+		return nil, fmt.Errorf("%w: %d x %d grid", ErrMalformed, cols, rows)
+	case uint64(ff.VectorsLength()) != cols*rows:
+		return nil, fmt.Errorf("%w: %d vectors for a %d x %d grid", ErrMalformed, ff.VectorsLength(), cols, rows)
+	}
+
+	f := NewFlowField(bounds, int(cols), int(rows))
+	var v state.Vec2f
+	for i := range f.vectors {
+		ff.Vectors(&v, i)
+		f.vectors[i] = vecmath.FromFB(&v)
+	}
+	return f, nil
+}
+
+func (f *FlowField) index(i, j int) int {
+	if i < 0 || i >= f.cols || j < 0 || j >= f.rows {
+		panic(fmt.Sprintf("flow: node (%d, %d) outside %d x %d grid", i, j, f.cols, f.rows))
+	}
+	return j*f.cols + i
+}
+
+func nodeCoord(lo, hi float32, i, n int) float32 {
+	if n == 1 {
+		return lo
+	}
+	return float32(float64(lo) + float64(i)*(float64(hi)-float64(lo))/float64(n-1))
+}
+
+// gridCoord maps c onto an axis of n nodes spanning [lo, hi], returning the
+// lower node index and the fraction of the way to the next one. c is clamped
+// to the axis first. A coordinate within rounding of a node snaps to it, so
+// positions from NodePosition sample their node exactly.
+func gridCoord(c, lo, hi float32, n int) (int, float32) {
+	if n == 1 {
+		return 0, 0
+	}
+	g := (float64(c) - float64(lo)) / (float64(hi) - float64(lo)) * float64(n-1)
+	if g != g { // NaN
+		g = 0
+	}
+	g = min(max(g, 0), float64(n-1))
+	if r := math.Round(g); math.Abs(g-r) < 1e-9 {
+		g = r
+	}
+	i := min(int(g), n-2)
+	return i, float32(g - float64(i))
+}
@@ -0,0 +1,114 @@
+package flow
+
+import (
+	"errors"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// testField is a 4 x 3 field over [0, 30) x [0, 20) whose node (i, j) holds
+// (i, j*10 - 5), with deliberately non-power-of-two spacing.
+func testField() *FlowField {
+	f := NewFlowField(vecmath.AABB{Max: vecmath.Vec2f{X: 30, Y: 20}}, 4, 3)
+	for j := range f.Rows() {
+		for i := range f.Cols() {
+			f.Set(i, j, vecmath.Vec2f{X: float32(i), Y: float32(j*10 - 5)})
+		}
+	}
+	return f
+}
+
+func TestSampleOnNodes(t *testing.T) {
+	f := testField()
+	for j := range f.Rows() {
+		for i := range f.Cols() {
+			if got := f.Sample(f.NodePosition(i, j)); got != f.At(i, j) {
+				t.Errorf("Sample at node (%d, %d) = %v, want %v", i, j, got, f.At(i, j))
+			}
+		}
+	}
+}
+
+func TestSampleInterpolatesMonotonically(t *testing.T) {
+	f := testField()
+	a, b := f.NodePosition(1, 1), f.NodePosition(2, 2)
+	prev := f.Sample(a)
+	for k := 1; k <= 100; k++ {
+		got := f.Sample(vecmath.Lerp(a, b, float32(k)/100))
+		if got.X < prev.X || got.Y < prev.Y {
+			t.Fatalf("step %d: sample %v decreased from %v", k, got, prev)
+		}
+		prev = got
+	}
+	if mid := f.Sample(vecmath.Lerp(a, b, 0.5)); mid != (vecmath.Vec2f{X: 1.5, Y: 10}) {
+		t.Errorf("midpoint sample = %v, want {1.5 10}", mid)
+	}
+}
+
+func TestSampleClampsOutsideBounds(t *testing.T) {
+	f := testField()
+	tests := []struct {
+		pos  vecmath.Vec2f
+		want vecmath.Vec2f
+	}{
+		{vecmath.Vec2f{X: -100, Y: -100}, f.At(0, 0)},
+		{vecmath.Vec2f{X: 1000, Y: 1000}, f.At(3, 2)},
+		{vecmath.Vec2f{X: 1000, Y: -1}, f.At(3, 0)},
+		{vecmath.Vec2f{X: 15, Y: 50}, f.Sample(vecmath.Vec2f{X: 15, Y: 20})},
+	}
+	for _, tt := range tests {
+		if got := f.Sample(tt.pos); got != tt.want {
+			t.Errorf("Sample(%v) = %v, want %v", tt.pos, got, tt.want)
+		}
+	}
+}
+
+func TestSingleNodeAxis(t *testing.T) {
+	f := NewFlowField(vecmath.AABB{Max: vecmath.Vec2f{X: 10, Y: 10}}, 1, 2)
+	f.Set(0, 0, vecmath.Vec2f{X: 1})
+	f.Set(0, 1, vecmath.Vec2f{X: 3})
+	if got := f.Sample(vecmath.Vec2f{X: 7, Y: 5}); got != (vecmath.Vec2f{X: 2}) {
+		t.Errorf("Sample = %v, want {2 0}", got)
+	}
+}
+
+func TestFlowFieldRoundTrip(t *testing.T) {
+	f := testField()
+	buf := f.Finish(flatbuffers.NewBuilder(0))
+	got, err := FromFB(state.GetRootAsFlowField(buf, 0))
+	if err != nil {
+		t.Fatalf("FromFB: %v", err)
+	}
+	if got.Bounds() != f.Bounds() || got.Cols() != f.Cols() || got.Rows() != f.Rows() {
+		t.Fatalf("decoded %v %dx%d, want %v %dx%d", got.Bounds(), got.Cols(), got.Rows(), f.Bounds(), f.Cols(), f.Rows())
+	}
+	for j := range f.Rows() {
+		for i := range f.Cols() {
+			if got.At(i, j) != f.At(i, j) {
+				t.Errorf("node (%d, %d) = %v, want %v", i, j, got.At(i, j), f.At(i, j))
+			}
+		}
+	}
+}
+
+func TestFromFBRejectsMalformed(t *testing.T) {
+	buf := testField().Finish(flatbuffers.NewBuilder(0))
+	ff := state.GetRootAsFlowField(buf, 0)
+	ff.MutateRows(4)
+	if _, err := FromFB(ff); !errors.Is(err, ErrMalformed) {
+		t.Errorf("vector count mismatch: err = %v, want ErrMalformed", err)
+	}
+
+	b := flatbuffers.NewBuilder(0)
+	state.FlowFieldStart(b)
+	state.FlowFieldAddCols(b, 1)
+	state.FlowFieldAddRows(b, 1)
+	state.FinishFlowFieldBuffer(b, state.FlowFieldEnd(b))
+	if _, err := FromFB(state.GetRootAsFlowField(b.FinishedBytes(), 0)); !errors.Is(err, ErrMalformed) {
+		t.Errorf("missing bounds: err = %v, want ErrMalformed", err)
+	}
+}
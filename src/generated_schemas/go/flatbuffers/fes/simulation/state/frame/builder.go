@@ -0,0 +1,136 @@
+// Package frame builds and validates state.Frame buffers from plain Go values,
+// so callers do not have to drive the generated Start/Add/End functions or
+// manage vector and union construction by hand.
+package frame
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// FrameBuilder describes a Frame to serialize. Entities and obstacles are
+// written in slice order.
+type FrameBuilder struct {
+	Tick        uint64
+	TimestampNs int64
+	// Bounds is the world extents at this tick. The zero box is not written,
+	// and reads back as an absent (nil) Frame.Bounds.
+	Bounds   vecmath.AABB
+	Entities []EntityArgs
+	// Obstacles are written only if there are any, so frames without
+	// obstacles read back with no obstacles vector at all.
+	Obstacles []ObstacleArgs
+}
+
+// Build writes the frame and its entity tables into builder and returns the
+// offset of the Frame table. The frame is stamped with SchemaVersion. The
+// builder must not be in the middle of another object.
+func (fb *FrameBuilder) Build(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	offsets := make([]flatbuffers.UOffsetT, len(fb.Entities))
+	for i, e := range fb.Entities {
+		kind, entity := BuildEntity(builder, e)
+		state.FrameEntityStart(builder)
+		state.FrameEntityAddEntityType(builder, kind)
+		state.FrameEntityAddEntity(builder, entity)
+		offsets[i] = state.FrameEntityEnd(builder)
+	}
+
+	state.FrameStartEntitiesVector(builder, len(offsets))
+	for i := len(offsets) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(offsets[i])
+	}
+	entities := builder.EndVector(len(offsets))
+
+	var obstacles flatbuffers.UOffsetT
+	if len(fb.Obstacles) > 0 {
+		offsets = offsets[:0]
+		for _, o := range fb.Obstacles {
+			offsets = append(offsets, BuildObstacle(builder, o))
+		}
+		state.FrameStartObstaclesVector(builder, len(offsets))
+		for i := len(offsets) - 1; i >= 0; i-- {
+			builder.PrependUOffsetT(offsets[i])
+		}
+		obstacles = builder.EndVector(len(offsets))
+	}
+
+	state.FrameStart(builder)
+	state.FrameAddTick(builder, fb.Tick)
+	state.FrameAddTimestampNs(builder, fb.TimestampNs)
+	state.FrameAddEntities(builder, entities)
+	state.FrameAddSchemaVersion(builder, SchemaVersion)
+	if fb.Bounds != (vecmath.AABB{}) {
+		state.FrameAddBounds(builder, vecmath.AABBToFB(builder, fb.Bounds))
+	}
+	if obstacles != 0 {
+		state.FrameAddObstacles(builder, obstacles)
+	}
+	return state.FrameEnd(builder)
+}
+
+// Finish builds the frame as the root of builder and returns the finished
+// bytes. The returned slice aliases the builder's buffer.
+func (fb *FrameBuilder) Finish(builder *flatbuffers.Builder) []byte {
+	state.FinishFrameBuffer(builder, fb.Build(builder))
+	return builder.FinishedBytes()
+}
+
+// BuildSorted is Build with the entities and obstacles each written in
+// ascending ID order instead of slice order, so that frames assembled from map
+// iteration or any other unordered source serialize identically. Items sharing
+// an ID keep their relative order. fb itself is not reordered.
+//
+// Identical logical state produces identical bytes only if the builders are
+// in the same state too, for example both fresh or both Reset: the builder
+// deduplicates vtables against everything already written into it.
+func (fb *FrameBuilder) BuildSorted(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	sorted := *fb
+	sorted.Entities = slices.Clone(fb.Entities)
+	slices.SortStableFunc(sorted.Entities, func(a, b EntityArgs) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+	sorted.Obstacles = slices.Clone(fb.Obstacles)
+	slices.SortStableFunc(sorted.Obstacles, func(a, b ObstacleArgs) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return sorted.Build(builder)
+}
+
+// FinishSorted is Finish using BuildSorted.
+func (fb *FrameBuilder) FinishSorted(builder *flatbuffers.Builder) []byte {
+	state.FinishFrameBuffer(builder, fb.BuildSorted(builder))
+	return builder.FinishedBytes()
+}
+
+// Entities yields every entity in f whose kind this build understands, in
+// frame order. Entries of unknown kinds, such as tables added to the Entity
+// union after this build, are skipped.
+func Entities(f *state.Frame) iter.Seq[EntityArgs] {
+	return func(yield func(EntityArgs) bool) {
+		for i := 0; i < f.EntitiesLength(); i++ {
+			e, ok := EntityArgsFromFB(f.EntityAt(i))
+			if ok && !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Positions returns the position of every known entity in f, keyed by ID. A
+// nil frame yields an empty map.
+func Positions(f *state.Frame) map[uint32]vecmath.Vec2f {
+	if f == nil {
+		return map[uint32]vecmath.Vec2f{}
+	}
+	out := make(map[uint32]vecmath.Vec2f, f.EntitiesLength())
+	for e := range Entities(f) {
+		out[e.ID()] = e.Position()
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package frame
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func buildFrame(tick uint64, entities ...EntityArgs) *state.Frame {
+	fb := FrameBuilder{Tick: tick, TimestampNs: int64(tick) * 50e6, Entities: entities}
+	buf := fb.Finish(flatbuffers.NewBuilder(0))
+	return state.GetRootAsFrame(buf, 0)
+}
+
+func TestFrameBuilderRoundTrip(t *testing.T) {
+	want := []EntityArgs{
+		Fish(FishStateArgs{ID: 7, Position: vecmath.Vec2f{X: 1.5, Y: -2}, Energy: 3, Species: state.SpeciesPike}),
+		Food(FoodStateArgs{ID: 3, Position: vecmath.Vec2f{X: 0, Y: 10}, Nutrition: 0.5}),
+		Plant(PlantStateArgs{ID: 5, Position: vecmath.Vec2f{X: -4, Y: 4}}),
+	}
+	f := buildFrame(42, want...)
+
+	if f.Tick() != 42 || f.TimestampNs() != 42*50e6 {
+		t.Fatalf("tick/timestamp = %d/%d", f.Tick(), f.TimestampNs())
+	}
+	if f.EntitiesLength() != len(want) {
+		t.Fatalf("EntitiesLength = %d, want %d", f.EntitiesLength(), len(want))
+	}
+	for i, w := range want {
+		got, ok := EntityArgsFromFB(f.EntityAt(i))
+		if !ok || got != w {
+			t.Errorf("entity %d = %+v, %v, want %+v", i, got, ok, w)
+		}
+	}
+}
+
+func TestFrameBuilderEmpty(t *testing.T) {
+	f := buildFrame(1)
+	if f.EntitiesLength() != 0 {
+		t.Fatalf("EntitiesLength = %d, want 0", f.EntitiesLength())
+	}
+}
+
+func TestValidateFrame(t *testing.T) {
+	first := buildFrame(10)
+	if err := ValidateFrame(nil, first); err != nil {
+		t.Errorf("first frame: %v", err)
+	}
+	if err := ValidateFrame(first, buildFrame(11)); err != nil {
+		t.Errorf("advancing tick: %v", err)
+	}
+	for _, tick := range []uint64{10, 9} {
+		if err := ValidateFrame(first, buildFrame(tick)); !errors.Is(err, ErrNonMonotonicTick) {
+			t.Errorf("tick %d after 10: err = %v, want ErrNonMonotonicTick", tick, err)
+		}
+	}
+}
+
+func TestFinishSortedIsOrderIndependent(t *testing.T) {
+	entities := []EntityArgs{
+		Fish(FishStateArgs{ID: 5, Position: vecmath.Vec2f{X: 1, Y: 2}, Energy: 3}),
+		Food(FoodStateArgs{ID: 2, Position: vecmath.Vec2f{X: 4, Y: 4}, Nutrition: 1}),
+		Fish(FishStateArgs{ID: 9, Velocity: vecmath.Vec2f{X: 1}, Species: state.SpeciesGuppy}),
+		Plant(PlantStateArgs{ID: 1}),
+	}
+	reversed := slices.Clone(entities)
+	slices.Reverse(reversed)
+
+	a := FrameBuilder{Tick: 3, Entities: entities}
+	b := FrameBuilder{Tick: 3, Entities: reversed}
+	bufA := a.FinishSorted(flatbuffers.NewBuilder(0))
+	bufB := b.FinishSorted(flatbuffers.NewBuilder(0))
+	if !bytes.Equal(bufA, bufB) {
+		t.Fatal("same entities in a different order serialized differently")
+	}
+
+	if a.Entities[0].ID() != 5 {
+		t.Error("FinishSorted reordered the caller's slice")
+	}
+	f := state.GetRootAsFrame(bufA, 0)
+	var ids []uint32
+	for e := range Entities(f) {
+		ids = append(ids, e.ID())
+	}
+	if !slices.Equal(ids, []uint32{1, 2, 5, 9}) {
+		t.Errorf("IDs = %v, want ascending", ids)
+	}
+}
+
+func TestFrameBuilderBounds(t *testing.T) {
+	bounds := vecmath.AABB{Min: vecmath.Vec2f{X: -10, Y: 0.25}, Max: vecmath.Vec2f{X: 640.5, Y: 480}}
+	fb := FrameBuilder{Tick: 1, Bounds: bounds}
+	f := state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+	if got := vecmath.AABBFromFB(f.Bounds(nil)); got != bounds {
+		t.Fatalf("Bounds = %+v, want %+v", got, bounds)
+	}
+
+	if f := buildFrame(2); f.Bounds(nil) != nil {
+		t.Fatalf("frame built without bounds has Bounds %+v, want nil", vecmath.AABBFromFB(f.Bounds(nil)))
+	}
+}
@@ -0,0 +1,179 @@
+package frame
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// DiffKind says how an entity differs between two frames.
+type DiffKind uint8
+
+const (
+	// DiffOnlyInA is an entity present in the first frame but not the second.
+	DiffOnlyInA DiffKind = iota + 1
+	// DiffOnlyInB is an entity present in the second frame but not the first.
+	DiffOnlyInB
+	// DiffChanged is an entity present in both frames with differing fields.
+	DiffChanged
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case DiffOnlyInA:
+		return "only in a"
+	case DiffOnlyInB:
+		return "only in b"
+	case DiffChanged:
+		return "changed"
+	}
+	return fmt.Sprintf("DiffKind(%d)", uint8(k))
+}
+
+// DiffField is a set of entity fields that differ, one bit per field.
+type DiffField uint8
+
+const (
+	// FieldKind is set when the entity is of a different kind in each frame;
+	// no other fields are compared then.
+	FieldKind DiffField = 1 << iota
+	FieldPosition
+	FieldVelocity
+	FieldEnergy
+	FieldAgeTicks
+	FieldSpecies
+	FieldNutrition
+)
+
+var diffFieldNames = []string{"kind", "position", "velocity", "energy", "age_ticks", "species", "nutrition"}
+
+// String lists the fields in f separated by commas, such as
+// "position,energy".
+func (f DiffField) String() string {
+	var names []string
+	for i, name := range diffFieldNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// EntityDiff describes one entity that differs between two frames.
+type EntityDiff struct {
+	ID   uint32
+	Kind DiffKind
+	// Fields is the set of differing fields. It is zero unless Kind is
+	// DiffChanged.
+	Fields DiffField
+	// A and B are the entity as it appears in each frame; the side it is
+	// absent from is the zero EntityArgs.
+	A, B EntityArgs
+}
+
+func (d EntityDiff) String() string {
+	if d.Kind == DiffChanged {
+		return fmt.Sprintf("entity %d: %s: %s", d.ID, d.Kind, d.Fields)
+	}
+	return fmt.Sprintf("entity %d: %s", d.ID, d.Kind)
+}
+
+// DiffFrames compares the known entities of a and b, matched by ID whatever
+// order they were serialized in, and returns one EntityDiff per ID that
+// differs, sorted by ID. Identical frames yield an empty result.
+//
+// Positions and velocities differ when they are more than posEpsilon apart.
+// Every other field must match exactly, except that a NaN matches a NaN so
+// that a corrupt value present on both sides is not reported as a change.
+// Frame-level fields such as the tick are not compared. If a frame holds an
+// ID more than once, its last occurrence is used. A nil frame is treated as
+// empty, so every entity of the other is reported as only in that frame.
+func DiffFrames(a, b *state.Frame, posEpsilon float32) []EntityDiff {
+	inA := entitiesByID(a)
+	inB := entitiesByID(b)
+
+	ids := make([]uint32, 0, len(inA)+len(inB))
+	for id := range inA {
+		ids = append(ids, id)
+	}
+	for id := range inB {
+		if _, ok := inA[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	var out []EntityDiff
+	for _, id := range ids {
+		ea, okA := inA[id]
+		eb, okB := inB[id]
+		switch {
+		case !okB:
+			out = append(out, EntityDiff{ID: id, Kind: DiffOnlyInA, A: ea})
+		case !okA:
+			out = append(out, EntityDiff{ID: id, Kind: DiffOnlyInB, B: eb})
+		default:
+			if fields := diffEntity(ea, eb, posEpsilon); fields != 0 {
+				out = append(out, EntityDiff{ID: id, Kind: DiffChanged, Fields: fields, A: ea, B: eb})
+			}
+		}
+	}
+	return out
+}
+
+// entitiesByID indexes f's entities by ID. A nil frame has no entities.
+func entitiesByID(f *state.Frame) map[uint32]EntityArgs {
+	if f == nil {
+		return nil
+	}
+	out := make(map[uint32]EntityArgs, f.EntitiesLength())
+	for e := range Entities(f) {
+		out[e.ID()] = e
+	}
+	return out
+}
+
+func diffEntity(a, b EntityArgs, eps float32) DiffField {
+	if a.Kind != b.Kind {
+		return FieldKind
+	}
+	var d DiffField
+	if !nearVec(a.Position(), b.Position(), eps) {
+		d |= FieldPosition
+	}
+	switch a.Kind {
+	case state.EntityFishState:
+		if !nearVec(a.Fish.Velocity, b.Fish.Velocity, eps) {
+			d |= FieldVelocity
+		}
+		if !sameFloat(a.Fish.Energy, b.Fish.Energy) {
+			d |= FieldEnergy
+		}
+		if a.Fish.AgeTicks != b.Fish.AgeTicks {
+			d |= FieldAgeTicks
+		}
+		if a.Fish.Species != b.Fish.Species {
+			d |= FieldSpecies
+		}
+	case state.EntityFoodState:
+		if !sameFloat(a.Food.Nutrition, b.Food.Nutrition) {
+			d |= FieldNutrition
+		}
+	}
+	return d
+}
+
+func nearVec(a, b vecmath.Vec2f, eps float32) bool {
+	if sameFloat(a.X, b.X) && sameFloat(a.Y, b.Y) {
+		return true
+	}
+	// Written so that a NaN on one side only counts as a difference.
+	return vecmath.Distance(a, b) <= eps
+}
+
+func sameFloat(a, b float32) bool {
+	return a == b || (a != a && b != b)
+}
@@ -0,0 +1,95 @@
+package frame
+
+import (
+	"math"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestDiffFramesPositionEpsilon(t *testing.T) {
+	const eps = 0.01
+	base := func(x float32) *state.Frame {
+		return buildFrame(1,
+			Fish(FishStateArgs{ID: 1, Position: vecmath.Vec2f{X: 10, Y: 10}, Energy: 5}),
+			Fish(FishStateArgs{ID: 2, Position: vecmath.Vec2f{X: x, Y: 20}, Energy: 5}),
+		)
+	}
+	a := base(20)
+
+	if d := DiffFrames(a, base(20+eps*0.9), eps); len(d) != 0 {
+		t.Errorf("move just under epsilon: diffs = %v, want none", d)
+	}
+	d := DiffFrames(a, base(20+eps*1.1), eps)
+	if len(d) != 1 || d[0].ID != 2 || d[0].Kind != DiffChanged || d[0].Fields != FieldPosition {
+		t.Fatalf("move just over epsilon: diffs = %v, want entity 2 position", d)
+	}
+	if d[0].A.Position().X != 20 || d[0].B.Position().X != 20+eps*1.1 {
+		t.Errorf("diff carries positions %v and %v", d[0].A.Position(), d[0].B.Position())
+	}
+}
+
+func TestDiffFramesAddedRemovedAndChanged(t *testing.T) {
+	a := buildFrame(1,
+		Fish(FishStateArgs{ID: 9, Energy: 1, Species: state.SpeciesGuppy}),
+		Food(FoodStateArgs{ID: 4, Nutrition: 2}),
+		Fish(FishStateArgs{ID: 1, Energy: 3}),
+		Plant(PlantStateArgs{ID: 7}),
+		Fish(FishStateArgs{ID: 5, Energy: float32(math.NaN())}),
+	)
+	// Same survivors in a different order.
+	b := buildFrame(2,
+		Fish(FishStateArgs{ID: 5, Energy: float32(math.NaN())}),
+		Food(FoodStateArgs{ID: 7}),
+		Fish(FishStateArgs{ID: 9, Energy: 1.5, Species: state.SpeciesPike}),
+		Fish(FishStateArgs{ID: 1, Energy: 3}),
+		Fish(FishStateArgs{ID: 12}),
+	)
+
+	got := DiffFrames(a, b, 0)
+	want := []string{
+		"entity 4: only in a",
+		"entity 7: changed: kind",
+		"entity 9: changed: energy,species",
+		"entity 12: only in b",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("diffs = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("diff %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+	if got[0].A.Kind != state.EntityFoodState || got[0].B.Kind != state.EntityNONE {
+		t.Errorf("only-in-a diff sides = %v, %v", got[0].A.Kind, got[0].B.Kind)
+	}
+
+	if d := DiffFrames(a, a, 0); len(d) != 0 {
+		t.Errorf("frame against itself: %v", d)
+	}
+}
+
+func TestDiffFramesNilFrame(t *testing.T) {
+	f := buildFrame(1,
+		Fish(FishStateArgs{ID: 3, Energy: 1}),
+		Food(FoodStateArgs{ID: 1, Nutrition: 2}),
+	)
+	for _, tc := range []struct {
+		name string
+		a, b *state.Frame
+		kind DiffKind
+	}{
+		{"nil a", nil, f, DiffOnlyInB},
+		{"nil b", f, nil, DiffOnlyInA},
+	} {
+		d := DiffFrames(tc.a, tc.b, 0)
+		if len(d) != 2 || d[0].ID != 1 || d[1].ID != 3 || d[0].Kind != tc.kind || d[1].Kind != tc.kind {
+			t.Errorf("%s: diffs = %v, want entities 1 and 3 as %v", tc.name, d, tc.kind)
+		}
+	}
+	if d := DiffFrames(nil, nil, 0); len(d) != 0 {
+		t.Errorf("both nil: diffs = %v, want none", d)
+	}
+}
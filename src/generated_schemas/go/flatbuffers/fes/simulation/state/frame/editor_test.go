@@ -0,0 +1,83 @@
+package frame
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestFrameEditorBuild(t *testing.T) {
+	bounds := vecmath.AABB{Max: vecmath.Vec2f{X: 50, Y: 50}}
+	e := NewFrameEditor().
+		SetTick(12).
+		SetBounds(bounds).
+		AddFish(9, vecmath.Vec2f{X: 1, Y: 2}, vecmath.Vec2f{X: 0.5}, 7, state.SpeciesPike).
+		AddFood(3, vecmath.Vec2f{X: 4, Y: 4}, 2).
+		AddPlant(5, vecmath.Vec2f{X: 8})
+
+	first, err := e.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	second, err := e.Build()
+	if err != nil || !bytes.Equal(first, second) {
+		t.Fatalf("second Build differs (err %v)", err)
+	}
+	if err := VerifyFrame(first); err != nil {
+		t.Fatalf("VerifyFrame: %v", err)
+	}
+
+	f := state.GetRootAsFrame(first, 0)
+	if f.Tick() != 12 || vecmath.AABBFromFB(f.Bounds(nil)) != bounds {
+		t.Fatalf("tick %d, bounds %+v", f.Tick(), vecmath.AABBFromFB(f.Bounds(nil)))
+	}
+	want := []EntityArgs{
+		Food(FoodStateArgs{ID: 3, Position: vecmath.Vec2f{X: 4, Y: 4}, Nutrition: 2}),
+		Plant(PlantStateArgs{ID: 5, Position: vecmath.Vec2f{X: 8}}),
+		Fish(FishStateArgs{ID: 9, Position: vecmath.Vec2f{X: 1, Y: 2}, Velocity: vecmath.Vec2f{X: 0.5}, Energy: 7, Species: state.SpeciesPike}),
+	}
+	i := 0
+	for got := range Entities(f) {
+		if got != want[i] {
+			t.Errorf("entity %d = %+v, want %+v", i, got, want[i])
+		}
+		i++
+	}
+	if i != len(want) {
+		t.Errorf("%d entities, want %d", i, len(want))
+	}
+}
+
+func TestFrameEditorRejectsDuplicateIDs(t *testing.T) {
+	e := NewFrameEditor().
+		AddFish(4, vecmath.Vec2f{}, vecmath.Vec2f{}, 1, state.SpeciesGuppy).
+		AddFood(4, vecmath.Vec2f{X: 1}, 1)
+	buf, err := e.Build()
+	if err == nil || buf != nil || !strings.Contains(err.Error(), "ID 4") {
+		t.Fatalf("Build = %d bytes, %v, want an error naming ID 4", len(buf), err)
+	}
+}
+
+func TestEditFrameCopiesOnWrite(t *testing.T) {
+	orig := NewFrameEditor().SetTick(1).AddPlant(1, vecmath.Vec2f{X: 1}).MustBuild()
+	snapshot := bytes.Clone(orig)
+
+	edited := EditFrame(state.GetRootAsFrame(orig, 0)).
+		SetTick(2).
+		AddFood(2, vecmath.Vec2f{X: 2}, 3).
+		MustBuild()
+
+	if !bytes.Equal(orig, snapshot) {
+		t.Fatal("editing modified the source frame")
+	}
+	f := state.GetRootAsFrame(edited, 0)
+	if f.Tick() != 2 || f.EntitiesLength() != 2 {
+		t.Fatalf("edited frame: tick %d, %d entities", f.Tick(), f.EntitiesLength())
+	}
+	if err := EditFrame(f).AddPlant(1, vecmath.Vec2f{}).err; err == nil {
+		t.Fatal("EditFrame did not track the copied IDs")
+	}
+}
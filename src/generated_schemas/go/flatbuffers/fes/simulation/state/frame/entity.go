@@ -0,0 +1,140 @@
+package frame
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// FoodStateArgs holds the fields of a state.FoodState table.
+type FoodStateArgs struct {
+	ID        uint32
+	Position  vecmath.Vec2f
+	Nutrition float32
+}
+
+// PlantStateArgs holds the fields of a state.PlantState table.
+type PlantStateArgs struct {
+	ID       uint32
+	Position vecmath.Vec2f
+}
+
+// EntityArgs is a single entity of any kind. Kind selects which of Fish, Food
+// and Plant holds its fields; the other two are ignored. Use the Fish, Food
+// and Plant constructors rather than setting Kind by hand.
+type EntityArgs struct {
+	Kind  state.EntityKind
+	Fish  FishStateArgs
+	Food  FoodStateArgs
+	Plant PlantStateArgs
+}
+
+// Fish returns an EntityArgs holding a fish.
+func Fish(args FishStateArgs) EntityArgs {
+	return EntityArgs{Kind: state.EntityFishState, Fish: args}
+}
+
+// Food returns an EntityArgs holding a food item.
+func Food(args FoodStateArgs) EntityArgs {
+	return EntityArgs{Kind: state.EntityFoodState, Food: args}
+}
+
+// Plant returns an EntityArgs holding a plant.
+func Plant(args PlantStateArgs) EntityArgs {
+	return EntityArgs{Kind: state.EntityPlantState, Plant: args}
+}
+
+// ID returns the entity's ID, whatever its kind.
+func (e EntityArgs) ID() uint32 {
+	switch e.Kind {
+	case state.EntityFishState:
+		return e.Fish.ID
+	case state.EntityFoodState:
+		return e.Food.ID
+	case state.EntityPlantState:
+		return e.Plant.ID
+	}
+	return 0
+}
+
+// Position returns the entity's position, whatever its kind.
+func (e EntityArgs) Position() vecmath.Vec2f {
+	switch e.Kind {
+	case state.EntityFishState:
+		return e.Fish.Position
+	case state.EntityFoodState:
+		return e.Food.Position
+	case state.EntityPlantState:
+		return e.Plant.Position
+	}
+	return vecmath.Vec2f{}
+}
+
+// SetPosition updates the entity's position, whatever its kind.
+func (e *EntityArgs) SetPosition(p vecmath.Vec2f) {
+	switch e.Kind {
+	case state.EntityFishState:
+		e.Fish.Position = p
+	case state.EntityFoodState:
+		e.Food.Position = p
+	case state.EntityPlantState:
+		e.Plant.Position = p
+	}
+}
+
+// BuildEntity writes the table for e and returns its union tag and offset.
+// An EntityArgs of unknown kind writes nothing and returns EntityNONE.
+func BuildEntity(builder *flatbuffers.Builder, e EntityArgs) (state.EntityKind, flatbuffers.UOffsetT) {
+	switch e.Kind {
+	case state.EntityFishState:
+		return e.Kind, BuildFishState(builder, e.Fish)
+	case state.EntityFoodState:
+		return e.Kind, BuildFoodState(builder, e.Food)
+	case state.EntityPlantState:
+		return e.Kind, BuildPlantState(builder, e.Plant)
+	}
+	return state.EntityNONE, 0
+}
+
+// BuildFoodState writes a FoodState table from args and returns its offset.
+func BuildFoodState(builder *flatbuffers.Builder, args FoodStateArgs) flatbuffers.UOffsetT {
+	state.FoodStateStart(builder)
+	state.FoodStateAddId(builder, args.ID)
+	state.FoodStateAddPosition(builder, vecmath.ToFB(builder, args.Position))
+	state.FoodStateAddNutrition(builder, args.Nutrition)
+	return state.FoodStateEnd(builder)
+}
+
+// BuildPlantState writes a PlantState table from args and returns its offset.
+func BuildPlantState(builder *flatbuffers.Builder, args PlantStateArgs) flatbuffers.UOffsetT {
+	state.PlantStateStart(builder)
+	state.PlantStateAddId(builder, args.ID)
+	state.PlantStateAddPosition(builder, vecmath.ToFB(builder, args.Position))
+	return state.PlantStateEnd(builder)
+}
+
+// EntityArgsFromFB copies the entity table tab of the given kind, as returned
+// by state.Frame.EntityAt. It returns false for EntityNONE and for kinds this
+// build does not know, without reading tab.
+func EntityArgsFromFB(kind state.EntityKind, tab flatbuffers.Table) (EntityArgs, bool) {
+	switch kind {
+	case state.EntityFishState:
+		var f state.FishState
+		f.Init(tab.Bytes, tab.Pos)
+		return Fish(FishStateArgsFromFB(&f)), true
+	case state.EntityFoodState:
+		var f state.FoodState
+		f.Init(tab.Bytes, tab.Pos)
+		return Food(FoodStateArgs{
+			ID:        f.Id(),
+			Position:  vecmath.FromFB(f.Position(nil)),
+			Nutrition: f.Nutrition(),
+		}), true
+	case state.EntityPlantState:
+		var p state.PlantState
+		p.Init(tab.Bytes, tab.Pos)
+		return Plant(PlantStateArgs{ID: p.Id(), Position: vecmath.FromFB(p.Position(nil))}), true
+	}
+	return EntityArgs{}, false
+}
@@ -0,0 +1,101 @@
+package frame
+
+import (
+	"maps"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// futureKind stands in for a union member added by a newer writer.
+const futureKind state.EntityKind = state.EntityPlantState + 1
+
+// frameWithFutureEntity builds a frame whose middle entity has a union tag
+// this build does not know, pointing at a table of an unknown shape.
+func frameWithFutureEntity() []byte {
+	b := flatbuffers.NewBuilder(0)
+	known := []EntityArgs{
+		Fish(FishStateArgs{ID: 1, Position: vecmath.Vec2f{X: 1, Y: 1}}),
+		Food(FoodStateArgs{ID: 3, Position: vecmath.Vec2f{X: 3, Y: 3}}),
+	}
+
+	// A table with a single float64 field, nothing like id/position.
+	b.StartObject(1)
+	b.PrependFloat64Slot(0, 2.5, 0)
+	future := b.EndObject()
+
+	var offsets []flatbuffers.UOffsetT
+	add := func(kind state.EntityKind, entity flatbuffers.UOffsetT) {
+		state.FrameEntityStart(b)
+		state.FrameEntityAddEntityType(b, kind)
+		state.FrameEntityAddEntity(b, entity)
+		offsets = append(offsets, state.FrameEntityEnd(b))
+	}
+	add(BuildEntity(b, known[0]))
+	add(futureKind, future)
+	add(BuildEntity(b, known[1]))
+
+	state.FrameStartEntitiesVector(b, len(offsets))
+	for i := len(offsets) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(offsets[i])
+	}
+	entities := b.EndVector(len(offsets))
+	state.FrameStart(b)
+	state.FrameAddTick(b, 1)
+	state.FrameAddSchemaVersion(b, SchemaVersion)
+	state.FrameAddEntities(b, entities)
+	state.FinishFrameBuffer(b, state.FrameEnd(b))
+	return b.FinishedBytes()
+}
+
+func TestUnknownEntityKindIsSkipped(t *testing.T) {
+	buf := frameWithFutureEntity()
+	if err := VerifyFrame(buf); err != nil {
+		t.Fatalf("VerifyFrame: %v", err)
+	}
+	f := state.GetRootAsFrame(buf, 0)
+
+	kind, tab := f.EntityAt(1)
+	if kind != futureKind {
+		t.Fatalf("EntityAt(1) kind = %v, want raw tag %d", kind, futureKind)
+	}
+	if _, ok := EntityArgsFromFB(kind, tab); ok {
+		t.Error("EntityArgsFromFB accepted an unknown kind")
+	}
+
+	var ids []uint32
+	for e := range Entities(f) {
+		ids = append(ids, e.ID())
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 3 {
+		t.Errorf("Entities yielded IDs %v, want [1 3]", ids)
+	}
+	want := map[uint32]vecmath.Vec2f{1: {X: 1, Y: 1}, 3: {X: 3, Y: 3}}
+	if got := Positions(f); !maps.Equal(got, want) {
+		t.Errorf("Positions = %v, want %v", got, want)
+	}
+}
+
+func TestEntityAtOutOfRange(t *testing.T) {
+	f := buildFrame(1, Food(FoodStateArgs{ID: 1}))
+	if kind, _ := f.EntityAt(1); kind != state.EntityNONE {
+		t.Errorf("EntityAt(1) kind = %v, want NONE", kind)
+	}
+}
+
+func TestEntityArgsSetPosition(t *testing.T) {
+	p := vecmath.Vec2f{X: 4, Y: -1}
+	for _, e := range []EntityArgs{
+		Fish(FishStateArgs{ID: 1}),
+		Food(FoodStateArgs{ID: 2}),
+		Plant(PlantStateArgs{ID: 3}),
+	} {
+		e.SetPosition(p)
+		if e.Position() != p {
+			t.Errorf("%v: Position = %v after SetPosition(%v)", e.Kind, e.Position(), p)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package frame
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// FishStateArgs holds the fields of a state.FishState table. Its zero value
+// is a stationary fish of species Unknown.
+type FishStateArgs struct {
+	ID       uint32
+	Position vecmath.Vec2f
+	// Velocity is optional on the wire: a zero velocity is not written, and
+	// readers treat an absent velocity as zero.
+	Velocity vecmath.Vec2f
+	Energy   float32
+	AgeTicks uint32
+	Species  state.Species
+}
+
+// BuildFishState writes a FishState table from args and returns its offset.
+// The builder must not be in the middle of another object.
+func BuildFishState(builder *flatbuffers.Builder, args FishStateArgs) flatbuffers.UOffsetT {
+	state.FishStateStart(builder)
+	state.FishStateAddId(builder, args.ID)
+	state.FishStateAddPosition(builder, vecmath.ToFB(builder, args.Position))
+	if args.Velocity != (vecmath.Vec2f{}) {
+		state.FishStateAddVelocity(builder, vecmath.ToFB(builder, args.Velocity))
+	}
+	state.FishStateAddEnergy(builder, args.Energy)
+	state.FishStateAddAgeTicks(builder, args.AgeTicks)
+	state.FishStateAddSpecies(builder, args.Species)
+	return state.FishStateEnd(builder)
+}
+
+// FishStateArgsFromFB copies a FishState table into a FishStateArgs.
+func FishStateArgsFromFB(f *state.FishState) FishStateArgs {
+	return FishStateArgs{
+		ID:       f.Id(),
+		Position: vecmath.FromFB(f.Position(nil)),
+		Velocity: vecmath.FromFB(f.Velocity(nil)),
+		Energy:   f.Energy(),
+		AgeTicks: f.AgeTicks(),
+		Species:  f.Species(),
+	}
+}
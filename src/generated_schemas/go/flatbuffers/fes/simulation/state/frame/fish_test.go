@@ -0,0 +1,43 @@
+package frame
+
+import (
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func buildFish(args FishStateArgs) *state.FishState {
+	b := flatbuffers.NewBuilder(0)
+	state.FinishFishStateBuffer(b, BuildFishState(b, args))
+	return state.GetRootAsFishState(b.FinishedBytes(), 0)
+}
+
+func TestBuildFishStateRoundTrip(t *testing.T) {
+	want := FishStateArgs{
+		ID:       12,
+		Position: vecmath.Vec2f{X: 3, Y: -4},
+		Velocity: vecmath.Vec2f{X: 0.5, Y: 1},
+		Energy:   87.5,
+		AgeTicks: 300,
+	}
+	if got := FishStateArgsFromFB(buildFish(want)); got != want {
+		t.Fatalf("round trip = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildFishStateDefaults(t *testing.T) {
+	f := buildFish(FishStateArgs{ID: 1, Position: vecmath.Vec2f{X: 1, Y: 1}})
+
+	if f.Velocity(nil) != nil {
+		t.Error("zero velocity must be omitted from the buffer")
+	}
+	if got := vecmath.FromFB(f.Velocity(nil)); got != (vecmath.Vec2f{}) {
+		t.Errorf("absent velocity reads as %v, want zero", got)
+	}
+	if f.Species() != state.SpeciesUnknown {
+		t.Errorf("Species = %v, want Unknown", f.Species())
+	}
+}
@@ -0,0 +1,122 @@
+// Package frametest provides test helpers for code that produces frames.
+//
+// GoldenFrame pins the serialized bytes of a frame to a file under testdata,
+// so an unintended change to the schema or to FrameBuilder fails a test. When
+// a change is intended, regenerate the files by running the affected
+// package's tests with -update, and commit the result:
+//
+//	go test ./path/to/pkg -run TestName -update
+//
+// Pass -update only to packages that use this helper: go test rejects flags a
+// test binary does not define, so "go test ./... -update" fails elsewhere.
+package frametest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+var update = flag.Bool("update", false, "rewrite golden frame files in testdata instead of comparing against them")
+
+// GoldenFrame compares buf against the golden file testdata/name.bin,
+// relative to the package under test, and fails t if they differ. With
+// -update it writes buf to that file instead.
+//
+// A mismatch is reported decoded, field by field: frame-level fields first,
+// then one line per differing entity as found by frame.DiffFrames with a zero
+// epsilon. Only if both frames decode identically, meaning the layout changed
+// but not the content, is the first differing byte offset reported.
+func GoldenFrame(t *testing.T, name string, buf []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".bin")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buf, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("updated %s", path)
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden frame: %v (run the test with -update to create it)", err)
+	}
+	if bytes.Equal(golden, buf) {
+		return
+	}
+	t.Errorf("frame differs from golden %s (run with -update if the change is intended):\n%s", path, describeDiff(golden, buf))
+}
+
+// describeDiff renders the differences between the golden and current frame
+// bytes, one per line.
+func describeDiff(golden, current []byte) string {
+	g, err := frame.DecodeFrame(golden)
+	if err != nil {
+		return fmt.Sprintf("  golden does not decode: %v", err)
+	}
+	c, err := frame.DecodeFrame(current)
+	if err != nil {
+		return fmt.Sprintf("  current does not decode: %v", err)
+	}
+
+	var lines []string
+	field := func(name string, gv, cv any) {
+		if gv != cv {
+			lines = append(lines, fmt.Sprintf("  %s: golden %v, current %v", name, gv, cv))
+		}
+	}
+	field("schema_version", g.SchemaVersion(), c.SchemaVersion())
+	field("tick", g.Tick(), c.Tick())
+	field("timestamp_ns", g.TimestampNs(), c.TimestampNs())
+	field("bounds", vecmath.AABBFromFB(g.Bounds(nil)), vecmath.AABBFromFB(c.Bounds(nil)))
+	if gObs, cObs := slices.Collect(frame.Obstacles(g)), slices.Collect(frame.Obstacles(c)); !slices.Equal(gObs, cObs) {
+		lines = append(lines, fmt.Sprintf("  obstacles: golden %+v, current %+v", gObs, cObs))
+	}
+
+	for _, d := range frame.DiffFrames(g, c, 0) {
+		switch d.Kind {
+		case frame.DiffOnlyInA:
+			lines = append(lines, fmt.Sprintf("  entity %d: only in golden: %s", d.ID, describeEntity(d.A)))
+		case frame.DiffOnlyInB:
+			lines = append(lines, fmt.Sprintf("  entity %d: only in current: %s", d.ID, describeEntity(d.B)))
+		default:
+			lines = append(lines, fmt.Sprintf("  entity %d: %s differ: golden %s, current %s",
+				d.ID, d.Fields, describeEntity(d.A), describeEntity(d.B)))
+		}
+	}
+
+	if len(lines) == 0 {
+		at := 0
+		for at < len(golden) && at < len(current) && golden[at] == current[at] {
+			at++
+		}
+		return fmt.Sprintf("  frames decode identically but bytes differ from offset %d (golden %d bytes, current %d bytes)",
+			at, len(golden), len(current))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func describeEntity(e frame.EntityArgs) string {
+	switch e.Kind {
+	case state.EntityFishState:
+		return fmt.Sprintf("FishState%+v", e.Fish)
+	case state.EntityFoodState:
+		return fmt.Sprintf("FoodState%+v", e.Food)
+	case state.EntityPlantState:
+		return fmt.Sprintf("PlantState%+v", e.Plant)
+	}
+	return e.Kind.String()
+}
@@ -0,0 +1,97 @@
+package frametest
+
+import (
+	"strings"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func sampleEditor() *frame.FrameEditor {
+	return frame.NewFrameEditor().
+		SetTick(42).
+		SetTimestampNs(1_700_000_000_000_000_000).
+		SetBounds(vecmath.AABB{Max: vecmath.Vec2f{X: 200, Y: 100}}).
+		AddFish(1, vecmath.Vec2f{X: 10, Y: 20}, vecmath.Vec2f{X: 1.5, Y: -0.5}, 55, state.SpeciesGuppy).
+		AddFish(2, vecmath.Vec2f{X: 150, Y: 80}, vecmath.Vec2f{}, 90, state.SpeciesPike).
+		AddFood(3, vecmath.Vec2f{X: 12, Y: 21}, 7.5).
+		AddPlant(4, vecmath.Vec2f{X: 100, Y: 0}).
+		AddObstacle(1, vecmath.AABB{Min: vecmath.Vec2f{X: 90, Y: 0}, Max: vecmath.Vec2f{X: 91, Y: 60}})
+}
+
+// TestGoldenSampleFrame pins the wire layout of a frame holding every entity
+// kind and frame-level field.
+func TestGoldenSampleFrame(t *testing.T) {
+	GoldenFrame(t, "sample", sampleEditor().MustBuild())
+}
+
+func TestDescribeDiffDecodesFields(t *testing.T) {
+	golden := sampleEditor().MustBuild()
+
+	f := state.GetRootAsFrame(golden, 0)
+	current := frame.EditFrame(f).
+		SetTick(43).
+		AddFish(9, vecmath.Vec2f{}, vecmath.Vec2f{}, 1, state.SpeciesCatfish).
+		MustBuild()
+	// Energy of fish 1 and species of fish 2 change in place.
+	cf := state.GetRootAsFrame(current, 0)
+	for i := 0; i < cf.EntitiesLength(); i++ {
+		kind, tab := cf.EntityAt(i)
+		if kind != state.EntityFishState {
+			continue
+		}
+		var fish state.FishState
+		fish.Init(tab.Bytes, tab.Pos)
+		switch fish.Id() {
+		case 1:
+			fish.MutateEnergy(54)
+		case 2:
+			fish.MutateSpecies(state.SpeciesAngelfish)
+		}
+	}
+
+	got := describeDiff(golden, current)
+	for _, want := range []string{
+		"tick: golden 42, current 43",
+		"entity 1: energy differ: golden FishState{ID:1 ",
+		"entity 2: species differ:",
+		"entity 9: only in current: FishState{ID:9 ",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("diff is missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "entity 3") || strings.Contains(got, "offset") {
+		t.Errorf("diff reports unchanged content:\n%s", got)
+	}
+}
+
+func TestDescribeDiffLayoutOnly(t *testing.T) {
+	golden := sampleEditor().MustBuild()
+	// Same content, but without sorting by ID the entities vector and the
+	// tables it points at are laid out differently.
+	f := state.GetRootAsFrame(golden, 0)
+	fb := frame.FrameBuilder{
+		Tick:        f.Tick(),
+		TimestampNs: f.TimestampNs(),
+		Bounds:      vecmath.AABBFromFB(f.Bounds(nil)),
+	}
+	for e := range frame.Entities(f) {
+		fb.Entities = append([]frame.EntityArgs{e}, fb.Entities...)
+	}
+	for o := range frame.Obstacles(f) {
+		fb.Obstacles = append(fb.Obstacles, o)
+	}
+	current := fb.Finish(flatbuffers.NewBuilder(0))
+
+	if got := describeDiff(golden, current); !strings.Contains(got, "decode identically but bytes differ from offset") {
+		t.Errorf("describeDiff = %q, want a layout-only report", got)
+	}
+	if got := describeDiff(golden, current[:8]); !strings.Contains(got, "current does not decode") {
+		t.Errorf("describeDiff(truncated) = %q", got)
+	}
+}
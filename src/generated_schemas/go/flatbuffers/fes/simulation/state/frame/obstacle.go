@@ -0,0 +1,61 @@
+package frame
+
+import (
+	"iter"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// ObstacleArgs holds the fields of a single Obstacle table.
+type ObstacleArgs struct {
+	ID     uint32
+	Bounds vecmath.AABB
+}
+
+// BuildObstacle writes a single Obstacle table into builder and returns its
+// offset. The builder must not be in the middle of another object.
+func BuildObstacle(builder *flatbuffers.Builder, o ObstacleArgs) flatbuffers.UOffsetT {
+	state.ObstacleStart(builder)
+	state.ObstacleAddId(builder, o.ID)
+	state.ObstacleAddBounds(builder, vecmath.AABBToFB(builder, o.Bounds))
+	return state.ObstacleEnd(builder)
+}
+
+// ObstacleArgsFromFB copies an Obstacle table into a value. Absent bounds
+// read as the zero (empty) box.
+func ObstacleArgsFromFB(o *state.Obstacle) ObstacleArgs {
+	return ObstacleArgs{ID: o.Id(), Bounds: vecmath.AABBFromFB(o.Bounds(nil))}
+}
+
+// Obstacles yields every obstacle in f, in frame order.
+func Obstacles(f *state.Frame) iter.Seq[ObstacleArgs] {
+	return func(yield func(ObstacleArgs) bool) {
+		var o state.Obstacle
+		for i := 0; i < f.ObstaclesLength(); i++ {
+			f.Obstacles(&o, i)
+			if !yield(ObstacleArgsFromFB(&o)) {
+				return
+			}
+		}
+	}
+}
+
+// ObstaclesNear returns the IDs of the obstacles in f that reach within radius
+// of center, in frame order, using the same test as vecmath.AABB's
+// IntersectsCircle. The result is never nil: a frame with no obstacles, or
+// none in range, yields an empty slice. A nil frame has no obstacles.
+func ObstaclesNear(f *state.Frame, center vecmath.Vec2f, radius float32) []uint32 {
+	out := []uint32{}
+	if f == nil {
+		return out
+	}
+	for o := range Obstacles(f) {
+		if o.Bounds.IntersectsCircle(center, radius) {
+			out = append(out, o.ID)
+		}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package frame
+
+import (
+	"slices"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func box(minX, minY, maxX, maxY float32) vecmath.AABB {
+	return vecmath.AABB{Min: vecmath.Vec2f{X: minX, Y: minY}, Max: vecmath.Vec2f{X: maxX, Y: maxY}}
+}
+
+func TestObstaclesRoundTrip(t *testing.T) {
+	want := []ObstacleArgs{
+		{ID: 7, Bounds: box(0, 0, 2, 2)},
+		{ID: 3, Bounds: box(10, -5, 10.1, 5)},
+	}
+	fb := FrameBuilder{Tick: 1, Obstacles: want}
+	buf := fb.Finish(flatbuffers.NewBuilder(0))
+	if err := VerifyFrame(buf); err != nil {
+		t.Fatalf("VerifyFrame: %v", err)
+	}
+	got := slices.Collect(Obstacles(state.GetRootAsFrame(buf, 0)))
+	if !slices.Equal(got, want) {
+		t.Fatalf("obstacles = %+v, want %+v", got, want)
+	}
+
+	sorted := fb.FinishSorted(flatbuffers.NewBuilder(0))
+	got = slices.Collect(Obstacles(state.GetRootAsFrame(sorted, 0)))
+	if len(got) != 2 || got[0].ID != 3 || got[1].ID != 7 {
+		t.Fatalf("sorted obstacles = %+v, want IDs 3, 7", got)
+	}
+}
+
+func TestObstaclesNear(t *testing.T) {
+	buf := NewFrameEditor().
+		AddObstacle(1, box(0, 4, 2, 6)).   // 3 left of center
+		AddObstacle(2, box(5, 10, 6, 12)). // 5 above center
+		AddObstacle(3, box(4, 4, 6, 6)).   // contains center
+		AddObstacle(4, box(5, 5, 5, 9)).   // empty box: never near
+		MustBuild()
+	f := state.GetRootAsFrame(buf, 0)
+	center := vecmath.Vec2f{X: 5, Y: 5}
+
+	tests := []struct {
+		radius float32
+		want   []uint32
+	}{
+		{-1, []uint32{}},
+		{0, []uint32{3}},
+		{2.9, []uint32{3}},
+		{3, []uint32{1, 3}},
+		{4.9, []uint32{1, 3}},
+		{5, []uint32{1, 2, 3}},
+		{100, []uint32{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		got := ObstaclesNear(f, center, tt.radius)
+		if got == nil || !slices.Equal(got, tt.want) {
+			t.Errorf("ObstaclesNear(r=%v) = %#v, want %v", tt.radius, got, tt.want)
+		}
+	}
+}
+
+func TestObstaclesNearNoObstacles(t *testing.T) {
+	buf := NewFrameEditor().AddFish(1, vecmath.Vec2f{}, vecmath.Vec2f{}, 1, state.SpeciesGuppy).MustBuild()
+	f := state.GetRootAsFrame(buf, 0)
+	if n := f.ObstaclesLength(); n != 0 {
+		t.Fatalf("ObstaclesLength = %d, want 0", n)
+	}
+	for _, got := range [][]uint32{
+		ObstaclesNear(f, vecmath.Vec2f{}, 10),
+		ObstaclesNear(nil, vecmath.Vec2f{}, 10),
+	} {
+		if got == nil || len(got) != 0 {
+			t.Errorf("ObstaclesNear = %#v, want a non-nil empty slice", got)
+		}
+	}
+}
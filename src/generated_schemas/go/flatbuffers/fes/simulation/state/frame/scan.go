@@ -0,0 +1,102 @@
+package frame
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// ScanEntityHeaders calls fn with the ID, kind and species of each entity in
+// the frame buf, in order, until fn returns false. Only those scalars are
+// read: no position or velocity accessors are built and the rest of each
+// entity is not verified, which makes it much cheaper than DecodeFrame for
+// consumers such as population counters.
+//
+// The schema version is checked as DecodeFrame does, and every offset and
+// field the scan reads is bounds-checked first, so a corrupt buf yields a
+// *VerifyError rather than a panic. Fields past the point where fn stopped
+// are not examined. species is SpeciesUnknown for entities other than fish,
+// and entities of unknown kinds are reported with their raw kind and an ID of
+// 0, since this build cannot know where their ID lives.
+func ScanEntityHeaders(buf []byte, fn func(id uint32, kind state.EntityKind, species state.Species) bool) error {
+	version, err := readSchemaVersion(buf)
+	if err != nil {
+		return err
+	}
+	if version < MinSchemaVersion || version > MaxSchemaVersion {
+		return &ErrUnsupportedVersion{Got: version, Min: MinSchemaVersion, Max: MaxSchemaVersion}
+	}
+
+	v := verifier{buf: buf}
+	frame, err := v.root()
+	if err != nil {
+		return err
+	}
+	start, n, err := v.vector(frame, 2, flatbuffers.SizeUOffsetT, "entities")
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		id, kind, species, err := v.scanHeader(start + i*flatbuffers.SizeUOffsetT)
+		if err != nil {
+			return indexField(err, i)
+		}
+		if !fn(id, kind, species) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// scanHeader reads the header scalars of the FrameEntity whose offset is
+// stored at at. Field names use "entities[]", filled in by indexField.
+func (v *verifier) scanHeader(at int) (uint32, state.EntityKind, state.Species, error) {
+	const name = "entities[]"
+	pos, err := v.indirect(at, name)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	t, err := v.table(pos, name)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	kind := state.EntityNONE
+	if at, err := v.fieldOffset(t, 0, 1, name+".entity_type"); err != nil {
+		return 0, 0, 0, err
+	} else if at >= 0 {
+		kind = state.Entity(v.buf[at])
+	}
+	switch kind {
+	case state.EntityFishState, state.EntityFoodState, state.EntityPlantState:
+	default:
+		return 0, kind, state.SpeciesUnknown, nil
+	}
+
+	at, err = v.fieldOffset(t, 1, flatbuffers.SizeUOffsetT, name+".entity")
+	if err != nil || at < 0 {
+		return 0, kind, state.SpeciesUnknown, err
+	}
+	member, err := v.indirect(at, name+".entity")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	m, err := v.table(member, name+".entity")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	var id uint32
+	if at, err := v.fieldOffset(m, 0, 4, name+".id"); err != nil {
+		return 0, 0, 0, err
+	} else if at >= 0 {
+		id = flatbuffers.GetUint32(v.buf[at:])
+	}
+	species := state.SpeciesUnknown
+	if kind == state.EntityFishState {
+		if at, err := v.fieldOffset(m, 5, 1, name+".species"); err != nil {
+			return 0, 0, 0, err
+		} else if at >= 0 {
+			species = state.Species(int8(v.buf[at]))
+		}
+	}
+	return id, kind, species, nil
+}
@@ -0,0 +1,129 @@
+package frame
+
+import (
+	"errors"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+type header struct {
+	id      uint32
+	kind    state.EntityKind
+	species state.Species
+}
+
+func scanAll(t *testing.T, buf []byte) []header {
+	t.Helper()
+	var out []header
+	err := ScanEntityHeaders(buf, func(id uint32, kind state.EntityKind, species state.Species) bool {
+		out = append(out, header{id, kind, species})
+		return true
+	})
+	if err != nil {
+		t.Fatalf("ScanEntityHeaders: %v", err)
+	}
+	return out
+}
+
+func TestScanEntityHeaders(t *testing.T) {
+	buf := NewFrameEditor().
+		AddFish(1, vecmath.Vec2f{}, vecmath.Vec2f{}, 5, state.SpeciesPike).
+		AddFood(2, vecmath.Vec2f{}, 1).
+		AddFish(3, vecmath.Vec2f{}, vecmath.Vec2f{}, 5, state.SpeciesUnknown).
+		AddPlant(4, vecmath.Vec2f{}).
+		MustBuild()
+
+	got := scanAll(t, buf)
+	want := []header{
+		{1, state.EntityFishState, state.SpeciesPike},
+		{2, state.EntityFoodState, state.SpeciesUnknown},
+		{3, state.EntityFishState, state.SpeciesUnknown},
+		{4, state.EntityPlantState, state.SpeciesUnknown},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("scanned %d headers, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("header %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestScanEntityHeadersStopsEarly(t *testing.T) {
+	buf := NewFrameEditor().
+		AddFish(1, vecmath.Vec2f{}, vecmath.Vec2f{}, 1, state.SpeciesGuppy).
+		AddFish(2, vecmath.Vec2f{}, vecmath.Vec2f{}, 1, state.SpeciesPike).
+		AddFish(3, vecmath.Vec2f{}, vecmath.Vec2f{}, 1, state.SpeciesPike).
+		MustBuild()
+
+	var calls int
+	var firstPike uint32
+	err := ScanEntityHeaders(buf, func(id uint32, _ state.EntityKind, species state.Species) bool {
+		calls++
+		if species == state.SpeciesPike {
+			firstPike = id
+			return false
+		}
+		return true
+	})
+	if err != nil || firstPike != 2 || calls != 2 {
+		t.Fatalf("first pike = %d after %d calls (err %v), want 2 after 2", firstPike, calls, err)
+	}
+}
+
+func TestScanEntityHeadersUnknownKind(t *testing.T) {
+	got := scanAll(t, frameWithFutureEntity())
+	if len(got) != 3 || got[1] != (header{0, futureKind, state.SpeciesUnknown}) || got[2].id != 3 {
+		t.Fatalf("headers = %+v", got)
+	}
+}
+
+func TestScanEntityHeadersRejectsCorrupt(t *testing.T) {
+	buf := sampleFrameBytes()
+	for n := 0; n < len(buf); n++ {
+		err := ScanEntityHeaders(buf[:n], func(uint32, state.EntityKind, state.Species) bool { return true })
+		var ve *VerifyError
+		if err != nil && !errors.As(err, &ve) {
+			t.Fatalf("truncated to %d: error %v is not a *VerifyError", n, err)
+		}
+	}
+}
+
+func BenchmarkScanVsDecode10k(b *testing.B) {
+	e := NewFrameEditor()
+	for i := range 10000 {
+		e.AddFish(uint32(i), vecmath.Vec2f{X: float32(i)}, vecmath.Vec2f{Y: 1}, 3, state.Species(i%5))
+	}
+	buf := e.MustBuild()
+
+	b.Run("headers", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			pike := 0
+			_ = ScanEntityHeaders(buf, func(_ uint32, _ state.EntityKind, s state.Species) bool {
+				if s == state.SpeciesPike {
+					pike++
+				}
+				return true
+			})
+		}
+	})
+	b.Run("decode", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			f, err := DecodeFrame(buf)
+			if err != nil {
+				b.Fatal(err)
+			}
+			pike := 0
+			for e := range Entities(f) {
+				if e.Fish.Species == state.SpeciesPike {
+					pike++
+				}
+			}
+		}
+	})
+}
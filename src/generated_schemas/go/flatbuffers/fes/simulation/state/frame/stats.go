@@ -0,0 +1,66 @@
+package frame
+
+import (
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// FrameStats aggregates the fish in a frame for dashboards. Entities other
+// than fish are not included. For a frame with no fish every field is zero
+// and SpeciesCounts is empty.
+type FrameStats struct {
+	// Fish is the number of fish.
+	Fish int
+	// SpeciesCounts is the number of fish of each species present.
+	SpeciesCounts map[state.Species]int
+
+	TotalEnergy float32
+	MeanEnergy  float32
+	MinEnergy   float32
+	MaxEnergy   float32
+
+	// Centroid is the mean fish position.
+	Centroid vecmath.Vec2f
+	// Bounds spans the smallest to the largest fish coordinate on each axis.
+	// Both edges are positions of actual fish, so unlike the usual half-open
+	// AABB the fish on the Max edge are not Contained by it.
+	Bounds vecmath.AABB
+}
+
+// Summarize computes FrameStats in a single pass over f's entities. Sums are
+// accumulated in float64. A nil frame yields the zero FrameStats, whose
+// SpeciesCounts is nil rather than empty.
+func Summarize(f *state.Frame) FrameStats {
+	if f == nil {
+		return FrameStats{}
+	}
+	s := FrameStats{SpeciesCounts: make(map[state.Species]int)}
+	var energy float64
+	var centroid vecmath.Accumulator
+	for e := range Entities(f) {
+		if e.Kind != state.EntityFishState {
+			continue
+		}
+		fish := e.Fish
+		if s.Fish == 0 {
+			s.MinEnergy, s.MaxEnergy = fish.Energy, fish.Energy
+			s.Bounds = vecmath.AABB{Min: fish.Position, Max: fish.Position}
+		}
+		s.Fish++
+		s.SpeciesCounts[fish.Species]++
+		energy += float64(fish.Energy)
+		s.MinEnergy = min(s.MinEnergy, fish.Energy)
+		s.MaxEnergy = max(s.MaxEnergy, fish.Energy)
+		centroid.Add(fish.Position)
+		s.Bounds.Min.X = min(s.Bounds.Min.X, fish.Position.X)
+		s.Bounds.Min.Y = min(s.Bounds.Min.Y, fish.Position.Y)
+		s.Bounds.Max.X = max(s.Bounds.Max.X, fish.Position.X)
+		s.Bounds.Max.Y = max(s.Bounds.Max.Y, fish.Position.Y)
+	}
+	if s.Fish > 0 {
+		s.TotalEnergy = float32(energy)
+		s.MeanEnergy = float32(energy / float64(s.Fish))
+		s.Centroid = centroid.Mean()
+	}
+	return s
+}
@@ -0,0 +1,61 @@
+package frame
+
+import (
+	"maps"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestSummarizeMixedSpecies(t *testing.T) {
+	f := buildFrame(1,
+		Fish(FishStateArgs{ID: 1, Position: vecmath.Vec2f{X: 0, Y: 0}, Energy: 10, Species: state.SpeciesGuppy}),
+		Fish(FishStateArgs{ID: 2, Position: vecmath.Vec2f{X: 4, Y: -2}, Energy: 2, Species: state.SpeciesGuppy}),
+		Food(FoodStateArgs{ID: 3, Position: vecmath.Vec2f{X: 100, Y: 100}, Nutrition: 50}),
+		Fish(FishStateArgs{ID: 4, Position: vecmath.Vec2f{X: 2, Y: 8}, Energy: 6, Species: state.SpeciesPike}),
+	)
+	s := Summarize(f)
+
+	if s.Fish != 3 {
+		t.Errorf("Fish = %d, want 3", s.Fish)
+	}
+	wantCounts := map[state.Species]int{state.SpeciesGuppy: 2, state.SpeciesPike: 1}
+	if !maps.Equal(s.SpeciesCounts, wantCounts) {
+		t.Errorf("SpeciesCounts = %v, want %v", s.SpeciesCounts, wantCounts)
+	}
+	if s.TotalEnergy != 18 || s.MeanEnergy != 6 || s.MinEnergy != 2 || s.MaxEnergy != 10 {
+		t.Errorf("energy total/mean/min/max = %v/%v/%v/%v, want 18/6/2/10",
+			s.TotalEnergy, s.MeanEnergy, s.MinEnergy, s.MaxEnergy)
+	}
+	if s.Centroid != (vecmath.Vec2f{X: 2, Y: 2}) {
+		t.Errorf("Centroid = %v, want {2 2}", s.Centroid)
+	}
+	wantBounds := vecmath.AABB{Min: vecmath.Vec2f{X: 0, Y: -2}, Max: vecmath.Vec2f{X: 4, Y: 8}}
+	if s.Bounds != wantBounds {
+		t.Errorf("Bounds = %+v, want %+v", s.Bounds, wantBounds)
+	}
+}
+
+func TestSummarizeEmpty(t *testing.T) {
+	for name, f := range map[string]*state.Frame{
+		"no entities": buildFrame(1),
+		"no fish":     buildFrame(1, Plant(PlantStateArgs{ID: 1, Position: vecmath.Vec2f{X: 5, Y: 5}})),
+	} {
+		s := Summarize(f)
+		if s.SpeciesCounts == nil || len(s.SpeciesCounts) != 0 {
+			t.Errorf("%s: SpeciesCounts = %v, want empty", name, s.SpeciesCounts)
+		}
+		if s.Fish != 0 || s.TotalEnergy != 0 || s.MeanEnergy != 0 || s.MinEnergy != 0 || s.MaxEnergy != 0 ||
+			s.Centroid != (vecmath.Vec2f{}) || s.Bounds != (vecmath.AABB{}) {
+			t.Errorf("%s: stats = %+v, want zero", name, s)
+		}
+	}
+}
+
+func TestSummarizeNil(t *testing.T) {
+	s := Summarize(nil)
+	if s.SpeciesCounts != nil || s.Fish != 0 || s.TotalEnergy != 0 || s.Bounds != (vecmath.AABB{}) {
+		t.Errorf("Summarize(nil) = %+v, want the zero FrameStats", s)
+	}
+}
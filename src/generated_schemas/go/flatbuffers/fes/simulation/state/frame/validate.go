@@ -0,0 +1,83 @@
+package frame
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// ErrNonMonotonicTick is returned by ValidateFrame when a frame's tick does
+// not advance past the previous frame's.
+var ErrNonMonotonicTick = errors.New("frame: tick is not monotonically increasing")
+
+// ValidateFrame checks invariants the schema cannot express. prev is the frame
+// received immediately before curr, or nil if curr is the first; when given,
+// curr.Tick must be strictly greater than prev.Tick. Every known entity's
+// position, and every fish's velocity, must also be finite; otherwise the
+// error is an *ErrNonFinite.
+//
+// A nil curr is validated as an empty frame: it has no entities and tick 0,
+// so it fails the tick check whenever prev is given.
+//
+// Validation is optional: the schema accepts any tick order so that tools can
+// still load out-of-order or spliced recordings.
+func ValidateFrame(prev, curr *state.Frame) error {
+	var tick uint64
+	if curr != nil {
+		tick = curr.Tick()
+	}
+	if prev != nil && tick <= prev.Tick() {
+		return fmt.Errorf("%w: tick %d follows %d", ErrNonMonotonicTick, tick, prev.Tick())
+	}
+	if curr == nil {
+		return nil
+	}
+	return checkFinite(Entities(curr))
+}
+
+// CheckFinite is the finiteness part of ValidateFrame for entities that have
+// not been serialized yet, so a NaN can be caught before it is written rather
+// than by whoever reads the frame. It makes one pass over entities and does
+// not allocate unless it finds something, so it is cheap enough to leave on
+// in production behind a flag.
+func CheckFinite(entities []EntityArgs) error {
+	return checkFinite(slices.Values(entities))
+}
+
+func checkFinite(entities iter.Seq[EntityArgs]) error {
+	var bad []uint32
+	for e := range entities {
+		ok := e.Position().IsFinite()
+		if e.Kind == state.EntityFishState {
+			ok = ok && e.Fish.Velocity.IsFinite()
+		}
+		if !ok {
+			bad = append(bad, e.ID())
+		}
+	}
+	if bad != nil {
+		return &ErrNonFinite{IDs: bad}
+	}
+	return nil
+}
+
+// maxListedIDs caps how many IDs ErrNonFinite.Error spells out.
+const maxListedIDs = 10
+
+// ErrNonFinite is returned by ValidateFrame and CheckFinite when entities
+// have a NaN or infinite position or velocity component.
+type ErrNonFinite struct {
+	// IDs lists every offending entity, in frame order.
+	IDs []uint32
+}
+
+func (e *ErrNonFinite) Error() string {
+	if len(e.IDs) > maxListedIDs {
+		return fmt.Sprintf("frame: non-finite position or velocity in entities %v and %d more",
+			e.IDs[:maxListedIDs], len(e.IDs)-maxListedIDs)
+	}
+	return fmt.Sprintf("frame: non-finite position or velocity in entities %v", e.IDs)
+}
@@ -0,0 +1,83 @@
+package frame
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestValidateFrameNonFinite(t *testing.T) {
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(1))
+	entities := []EntityArgs{
+		Fish(FishStateArgs{ID: 1, Position: vecmath.Vec2f{X: 1, Y: 1}, Velocity: vecmath.Vec2f{X: 1}}),
+		Fish(FishStateArgs{ID: 2, Position: vecmath.Vec2f{X: nan}}),
+		Fish(FishStateArgs{ID: 3, Velocity: vecmath.Vec2f{Y: -inf}}),
+		Food(FoodStateArgs{ID: 4, Position: vecmath.Vec2f{Y: inf}}),
+		Plant(PlantStateArgs{ID: 5, Position: vecmath.Vec2f{X: -inf, Y: nan}}),
+		Plant(PlantStateArgs{ID: 6, Position: vecmath.Vec2f{X: math.MaxFloat32}}),
+		// Only positions and velocities are checked.
+		Fish(FishStateArgs{ID: 7, Energy: nan}),
+		Food(FoodStateArgs{ID: 8, Nutrition: inf}),
+	}
+	want := []uint32{2, 3, 4, 5}
+
+	fb := FrameBuilder{Tick: 1, Entities: entities}
+	f := state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+	for name, err := range map[string]error{
+		"ValidateFrame": ValidateFrame(nil, f),
+		"CheckFinite":   CheckFinite(entities),
+	} {
+		var nf *ErrNonFinite
+		if !errors.As(err, &nf) || !slices.Equal(nf.IDs, want) {
+			t.Errorf("%s = %v, want *ErrNonFinite listing %v", name, err, want)
+		}
+	}
+
+	if err := CheckFinite(entities[:1]); err != nil {
+		t.Errorf("CheckFinite(finite) = %v", err)
+	}
+	if err := ValidateFrame(nil, buildFrame(1, entities[0], entities[6])); err != nil {
+		t.Errorf("ValidateFrame(finite) = %v", err)
+	}
+}
+
+func TestValidateFrameNil(t *testing.T) {
+	if err := ValidateFrame(nil, buildFrame(0, Fish(FishStateArgs{ID: 1}))); err != nil {
+		t.Errorf("ValidateFrame(nil, first frame) = %v, want nil", err)
+	}
+	if err := ValidateFrame(nil, nil); err != nil {
+		t.Errorf("ValidateFrame(nil, nil) = %v, want nil", err)
+	}
+	if err := ValidateFrame(buildFrame(3), nil); !errors.Is(err, ErrNonMonotonicTick) {
+		t.Errorf("ValidateFrame(tick 3, nil) = %v, want ErrNonMonotonicTick", err)
+	}
+}
+
+func TestErrNonFiniteTruncatesList(t *testing.T) {
+	ids := make([]uint32, 25)
+	for i := range ids {
+		ids[i] = uint32(i)
+	}
+	msg := (&ErrNonFinite{IDs: ids}).Error()
+	if !strings.HasSuffix(msg, "[0 1 2 3 4 5 6 7 8 9] and 15 more") {
+		t.Errorf("Error() = %q", msg)
+	}
+}
+
+func TestCheckFiniteDoesNotAllocate(t *testing.T) {
+	entities := make([]EntityArgs, 1000)
+	for i := range entities {
+		entities[i] = Fish(FishStateArgs{ID: uint32(i), Position: vecmath.Vec2f{X: float32(i)}, Velocity: vecmath.Vec2f{Y: 1}})
+	}
+	if n := testing.AllocsPerRun(10, func() { _ = CheckFinite(entities) }); n != 0 {
+		t.Errorf("CheckFinite allocated %v times per run, want 0", n)
+	}
+}
@@ -0,0 +1,329 @@
+package frame
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// VerifyError reports the first part of a buffer that failed verification.
+type VerifyError struct {
+	// Field is the path of the offending field, such as "entities[3].position",
+	// or "root" for the Frame table itself.
+	Field string
+	// Reason describes what was wrong with it.
+	Reason string
+}
+
+func (e *VerifyError) Error() string {
+	return fmt.Sprintf("frame: verifying %s: %s", e.Field, e.Reason)
+}
+
+// indexField puts element index i into the "[]" of the field path of a
+// *VerifyError from scanHeader or VerifyFrame. Formatting the index only on
+// failure keeps both free of per-element allocations.
+func indexField(err error, i int) error {
+	var ve *VerifyError
+	if errors.As(err, &ve) {
+		ve.Field = strings.Replace(ve.Field, "[]", fmt.Sprintf("[%d]", i), 1)
+	}
+	return err
+}
+
+// fieldWithin prefixes the field path of a *VerifyError with the path of the
+// table it was reported relative to.
+func fieldWithin(err error, name string) error {
+	if err == nil {
+		return nil // errors.As would move ve to the heap on every call
+	}
+	var ve *VerifyError
+	if errors.As(err, &ve) {
+		if ve.Field == "" {
+			ve.Field = name
+		} else {
+			ve.Field = name + "." + ve.Field
+		}
+	}
+	return err
+}
+
+// VerifyFrame bounds-checks buf as a Frame root before any accessor is
+// trusted with it: the root table, every vtable, every field it references,
+// and every nested vector and table must lie entirely inside buf. Call it at
+// the network boundary on every inbound frame. The generated Go code has no
+// verifier of its own, and reading a truncated buffer through the accessors
+// either panics or returns garbage.
+//
+// A nil error means the accessors can read every field of the frame without
+// going out of bounds; it does not validate field values (see ValidateFrame).
+func VerifyFrame(buf []byte) error {
+	v := verifier{buf: buf}
+	frame, err := v.root()
+	if err != nil {
+		return err
+	}
+
+	if err := v.scalar(frame, 0, 8, "tick"); err != nil {
+		return err
+	}
+	if err := v.scalar(frame, 1, 8, "timestamp_ns"); err != nil {
+		return err
+	}
+
+	if err := v.scalar(frame, 3, 2, "schema_version"); err != nil {
+		return err
+	}
+	if err := v.scalar(frame, 4, 16, "bounds"); err != nil {
+		return err
+	}
+
+	// Element paths use "[]", filled in by indexField only on failure, so a
+	// valid frame verifies without allocating.
+	start, n, err := v.vector(frame, 2, flatbuffers.SizeUOffsetT, "entities")
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		pos, err := v.indirect(start+i*flatbuffers.SizeUOffsetT, "entities[]")
+		if err == nil {
+			err = v.frameEntity(pos)
+		}
+		if err != nil {
+			return indexField(err, i)
+		}
+	}
+
+	start, n, err = v.vector(frame, 5, flatbuffers.SizeUOffsetT, "obstacles")
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if err := v.obstacle(start + i*flatbuffers.SizeUOffsetT); err != nil {
+			return indexField(err, i)
+		}
+	}
+	return nil
+}
+
+// obstacle verifies the Obstacle table whose offset is stored at at.
+func (v *verifier) obstacle(at int) error {
+	const name = "obstacles[]"
+	pos, err := v.indirect(at, name)
+	if err != nil {
+		return err
+	}
+	t, err := v.table(pos, name)
+	if err != nil {
+		return err
+	}
+	if err := v.scalar(t, 0, 4, name+".id"); err != nil {
+		return err
+	}
+	return v.scalar(t, 1, 16, name+".bounds")
+}
+
+// readSchemaVersion verifies only as much of buf as is needed to read the
+// Frame's schema_version, and returns it. An absent field reads as 0.
+func readSchemaVersion(buf []byte) (uint16, error) {
+	v := verifier{buf: buf}
+	frame, err := v.root()
+	if err != nil {
+		return 0, err
+	}
+	at, err := v.fieldOffset(frame, 3, 2, "schema_version")
+	if err != nil || at < 0 {
+		return 0, err
+	}
+	return flatbuffers.GetUint16(buf[at:]), nil
+}
+
+// root verifies the root offset and the root table it points to.
+func (v *verifier) root() (vtab, error) {
+	if len(v.buf) < flatbuffers.SizeUOffsetT {
+		return vtab{}, v.fail("root", "buffer too short for root offset")
+	}
+	pos, err := v.indirect(0, "root")
+	if err != nil {
+		return vtab{}, err
+	}
+	return v.table(pos, "root")
+}
+
+// frameEntity verifies a FrameEntity wrapper and the union member it points
+// to. Members of a kind this build does not know are only checked to start
+// inside the buffer, so frames from newer writers still verify.
+func (v *verifier) frameEntity(pos int) error {
+	const name = "entities[]"
+	t, err := v.table(pos, name)
+	if err != nil {
+		return err
+	}
+	at, err := v.fieldOffset(t, 0, 1, name+".entity_type")
+	if err != nil {
+		return err
+	}
+	kind := state.EntityNONE
+	if at >= 0 {
+		kind = state.Entity(v.buf[at])
+	}
+	at, err = v.fieldOffset(t, 1, flatbuffers.SizeUOffsetT, name+".entity")
+	if err != nil || at < 0 {
+		return err
+	}
+	member, err := v.indirect(at, name+".entity")
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case state.EntityFishState:
+		return fieldWithin(v.fishState(member), name+".FishState")
+	case state.EntityFoodState:
+		t, err := v.entityHeader(member)
+		if err == nil {
+			err = v.scalar(t, 2, 4, "nutrition")
+		}
+		return fieldWithin(err, name+".FoodState")
+	case state.EntityPlantState:
+		_, err := v.entityHeader(member)
+		return fieldWithin(err, name+".PlantState")
+	}
+	return nil
+}
+
+// entityHeader verifies the id and position fields that every Entity union
+// member starts with. Like fishState it names fields relative to the member
+// table, which reports itself as "".
+func (v *verifier) entityHeader(pos int) (vtab, error) {
+	t, err := v.table(pos, "")
+	if err != nil {
+		return vtab{}, err
+	}
+	if err := v.scalar(t, 0, 4, "id"); err != nil {
+		return vtab{}, err
+	}
+	return t, v.scalar(t, 1, 8, "position")
+}
+
+func (v *verifier) fishState(pos int) error {
+	t, err := v.entityHeader(pos)
+	if err != nil {
+		return err
+	}
+	if err := v.scalar(t, 2, 8, "velocity"); err != nil {
+		return err
+	}
+	if err := v.scalar(t, 3, 4, "energy"); err != nil {
+		return err
+	}
+	if err := v.scalar(t, 4, 4, "age_ticks"); err != nil {
+		return err
+	}
+	return v.scalar(t, 5, 1, "species")
+}
+
+// verifier walks a FlatBuffers buffer checking offsets against its length.
+// Offsets are widened to int so that hostile uint32 values cannot wrap.
+type verifier struct {
+	buf []byte
+}
+
+// vtab is a table whose vtable has been verified.
+type vtab struct {
+	pos    int // start of the table
+	vtable int // start of its vtable
+	vtLen  int // vtable size in bytes
+}
+
+func (v *verifier) fail(field, format string, args ...any) error {
+	return &VerifyError{Field: field, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (v *verifier) inBounds(pos, size int) bool {
+	return pos >= 0 && size >= 0 && pos <= len(v.buf) && size <= len(v.buf)-pos
+}
+
+// indirect follows the uoffset stored at pos, returning its target.
+func (v *verifier) indirect(pos int, field string) (int, error) {
+	if !v.inBounds(pos, flatbuffers.SizeUOffsetT) {
+		return 0, v.fail(field, "offset at %d out of bounds", pos)
+	}
+	target := pos + int(flatbuffers.GetUOffsetT(v.buf[pos:]))
+	if !v.inBounds(target, 0) {
+		return 0, v.fail(field, "offset at %d points to %d, past end of buffer (%d)", pos, target, len(v.buf))
+	}
+	return target, nil
+}
+
+func (v *verifier) table(pos int, field string) (vtab, error) {
+	if pos%4 != 0 {
+		return vtab{}, v.fail(field, "table at %d is misaligned", pos)
+	}
+	if !v.inBounds(pos, flatbuffers.SizeSOffsetT) {
+		return vtab{}, v.fail(field, "table at %d out of bounds", pos)
+	}
+	vt := pos - int(flatbuffers.GetSOffsetT(v.buf[pos:]))
+	if vt%2 != 0 || !v.inBounds(vt, 2*flatbuffers.SizeVOffsetT) {
+		return vtab{}, v.fail(field, "vtable at %d out of bounds", vt)
+	}
+	vtLen := int(flatbuffers.GetVOffsetT(v.buf[vt:]))
+	if vtLen < 4 || vtLen%2 != 0 || !v.inBounds(vt, vtLen) {
+		return vtab{}, v.fail(field, "vtable at %d has invalid size %d", vt, vtLen)
+	}
+	return vtab{pos: pos, vtable: vt, vtLen: vtLen}, nil
+}
+
+// fieldOffset returns the absolute position of field slot in t, or -1 if the
+// field is absent. The field's size bytes must lie inside the buffer.
+//
+// The inline table size recorded in the vtable is not a reliable bound: the
+// Go builder shares a vtable between tables whose field offsets match without
+// comparing their sizes, so a table can legitimately extend past the size its
+// (shared) vtable records.
+func (v *verifier) fieldOffset(t vtab, slot, size int, field string) (int, error) {
+	entry := 4 + 2*slot
+	if entry+2 > t.vtLen {
+		return -1, nil
+	}
+	o := int(flatbuffers.GetVOffsetT(v.buf[t.vtable+entry:]))
+	if o == 0 {
+		return -1, nil
+	}
+	if !v.inBounds(t.pos+o, size) {
+		return -1, v.fail(field, "field extends past end of buffer")
+	}
+	return t.pos + o, nil
+}
+
+// scalar verifies an inline scalar or struct field of the given size.
+func (v *verifier) scalar(t vtab, slot, size int, field string) error {
+	_, err := v.fieldOffset(t, slot, size, field)
+	return err
+}
+
+// vector verifies a vector field of elemSize-byte elements and returns the
+// position of its first element and its length. An absent vector has length
+// zero.
+func (v *verifier) vector(t vtab, slot, elemSize int, field string) (start, n int, err error) {
+	at, err := v.fieldOffset(t, slot, flatbuffers.SizeUOffsetT, field)
+	if err != nil || at < 0 {
+		return 0, 0, err
+	}
+	vec, err := v.indirect(at, field)
+	if err != nil {
+		return 0, 0, err
+	}
+	if vec%4 != 0 || !v.inBounds(vec, flatbuffers.SizeUOffsetT) {
+		return 0, 0, v.fail(field, "vector length at %d out of bounds", vec)
+	}
+	n = int(flatbuffers.GetUOffsetT(v.buf[vec:]))
+	start = vec + flatbuffers.SizeUOffsetT
+	if n > (len(v.buf)-start)/elemSize {
+		return 0, 0, v.fail(field, "vector of %d elements overruns buffer", n)
+	}
+	return start, n, nil
+}
@@ -0,0 +1,138 @@
+package frame
+
+import (
+	"errors"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func sampleFrameBytes() []byte {
+	fb := FrameBuilder{
+		Tick:        9,
+		TimestampNs: 1234,
+		Bounds:      vecmath.AABB{Max: vecmath.Vec2f{X: 100, Y: 100}},
+		Entities: []EntityArgs{
+			Fish(FishStateArgs{ID: 1, Position: vecmath.Vec2f{X: 1, Y: 2}, Velocity: vecmath.Vec2f{X: 1}, Energy: 2}),
+			Food(FoodStateArgs{ID: 2, Position: vecmath.Vec2f{X: 3, Y: 4}, Nutrition: 1}),
+			Plant(PlantStateArgs{ID: 3, Position: vecmath.Vec2f{X: 5, Y: 6}}),
+		},
+		Obstacles: []ObstacleArgs{{ID: 1, Bounds: vecmath.AABB{Min: vecmath.Vec2f{X: 10, Y: 10}, Max: vecmath.Vec2f{X: 12, Y: 30}}}},
+	}
+	return fb.Finish(flatbuffers.NewBuilder(0))
+}
+
+func TestVerifyFrameAcceptsValid(t *testing.T) {
+	if err := VerifyFrame(sampleFrameBytes()); err != nil {
+		t.Fatalf("VerifyFrame: %v", err)
+	}
+	empty := (&FrameBuilder{}).Finish(flatbuffers.NewBuilder(0))
+	if err := VerifyFrame(empty); err != nil {
+		t.Fatalf("VerifyFrame(empty frame): %v", err)
+	}
+}
+
+func TestVerifyFrameRejectsTruncated(t *testing.T) {
+	buf := sampleFrameBytes()
+	for n := 0; n < len(buf); n++ {
+		err := VerifyFrame(buf[:n])
+		if err == nil {
+			// A prefix can only verify if nothing it references was cut.
+			readAll(state.GetRootAsFrame(buf[:n], 0))
+			continue
+		}
+		var ve *VerifyError
+		if !errors.As(err, &ve) {
+			t.Fatalf("truncated to %d: error %v is not a *VerifyError", n, err)
+		}
+	}
+	if err := VerifyFrame(buf[:len(buf)/2]); err == nil {
+		t.Fatal("half a frame verified")
+	}
+}
+
+func TestVerifyFrameNamesField(t *testing.T) {
+	buf := sampleFrameBytes()
+	f := state.GetRootAsFrame(buf, 0)
+
+	// Corrupt the entities vector length so it claims more elements than fit.
+	tab := f.Table()
+	vec := tab.Vector(flatbuffers.UOffsetT(tab.Offset(8))) - flatbuffers.SizeUOffsetT
+	flatbuffers.WriteUint32(buf[vec:], 1<<30)
+
+	var ve *VerifyError
+	if err := VerifyFrame(buf); !errors.As(err, &ve) || ve.Field != "entities" {
+		t.Fatalf("VerifyFrame = %v, want error on field entities", err)
+	}
+}
+
+func TestVerifyFrameNamesElement(t *testing.T) {
+	for _, tc := range []struct {
+		want    string
+		corrupt func(buf []byte, f *state.Frame)
+	}{
+		{"entities[1]", func(buf []byte, f *state.Frame) {
+			tab := f.Table()
+			vec := tab.Vector(flatbuffers.UOffsetT(tab.Offset(8)))
+			flatbuffers.WriteUint32(buf[vec+flatbuffers.SizeUOffsetT:], 1<<30)
+		}},
+		{"obstacles[0]", func(buf []byte, f *state.Frame) {
+			tab := f.Table()
+			vec := tab.Vector(flatbuffers.UOffsetT(tab.Offset(14)))
+			flatbuffers.WriteUint32(buf[vec:], 1<<30)
+		}},
+		{"entities[0].FishState.position", func(buf []byte, f *state.Frame) {
+			// Point the fish's position vtable entry past the end of buf.
+			var fe state.FrameEntity
+			var member flatbuffers.Table
+			f.Entities(&fe, 0)
+			fe.Entity(&member)
+			vt := member.Pos - flatbuffers.UOffsetT(flatbuffers.GetSOffsetT(buf[member.Pos:]))
+			flatbuffers.WriteUint16(buf[vt+6:], 0xfff0)
+		}},
+	} {
+		buf := sampleFrameBytes()
+		tc.corrupt(buf, state.GetRootAsFrame(buf, 0))
+		var ve *VerifyError
+		if err := VerifyFrame(buf); !errors.As(err, &ve) || ve.Field != tc.want {
+			t.Errorf("VerifyFrame = %v, want error on field %s", err, tc.want)
+		}
+	}
+}
+
+func TestVerifyFrameDoesNotAllocate(t *testing.T) {
+	buf := sampleFrameBytes()
+	if n := testing.AllocsPerRun(10, func() { _ = VerifyFrame(buf) }); n != 0 {
+		t.Fatalf("VerifyFrame allocated %v times per run on a valid frame", n)
+	}
+}
+
+// readAll touches every field a consumer would read.
+func readAll(f *state.Frame) {
+	_ = f.Tick()
+	_ = f.TimestampNs()
+	for i := 0; i < f.EntitiesLength(); i++ {
+		_, _ = EntityArgsFromFB(f.EntityAt(i))
+	}
+	for range Obstacles(f) {
+	}
+}
+
+func FuzzVerifyFrame(f *testing.F) {
+	valid := sampleFrameBytes()
+	f.Add(valid)
+	f.Add(valid[:len(valid)-3])
+	f.Add([]byte{})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		if err := VerifyFrame(buf); err != nil {
+			return
+		}
+		// Anything that verifies must be safe to read in full.
+		readAll(state.GetRootAsFrame(buf, 0))
+	})
+}
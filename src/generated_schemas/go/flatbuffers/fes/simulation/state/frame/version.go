@@ -0,0 +1,52 @@
+package frame
+
+import (
+	"fmt"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// Frame layout versions. Bump SchemaVersion for a schema change that
+// FlatBuffers' field-level compatibility cannot absorb, such as changing a
+// field's type, and raise MinSchemaVersion once readers can no longer make
+// sense of older frames.
+//
+// Version 1 introduced the Entity union; frames written before it have no
+// schema_version field, read as version 0, and hold a vector of a table that
+// no longer exists.
+const (
+	// SchemaVersion is the version FrameBuilder stamps on every frame.
+	SchemaVersion uint16 = 1
+	// MinSchemaVersion is the oldest version DecodeFrame accepts.
+	MinSchemaVersion uint16 = 1
+	// MaxSchemaVersion is the newest version DecodeFrame accepts.
+	MaxSchemaVersion = SchemaVersion
+)
+
+// ErrUnsupportedVersion is returned by DecodeFrame for a frame whose
+// schema_version is outside [Min, Max].
+type ErrUnsupportedVersion struct {
+	Got, Min, Max uint16
+}
+
+func (e *ErrUnsupportedVersion) Error() string {
+	return fmt.Sprintf("frame: schema version %d not supported (want %d to %d)", e.Got, e.Min, e.Max)
+}
+
+// DecodeFrame checks buf's schema version and then verifies it, returning the
+// frame only if both pass. The version is read first, verifying just enough
+// of the buffer to reach it, so a frame from an incompatible build fails with
+// *ErrUnsupportedVersion rather than a confusing *VerifyError.
+func DecodeFrame(buf []byte) (*state.Frame, error) {
+	version, err := readSchemaVersion(buf)
+	if err != nil {
+		return nil, err
+	}
+	if version < MinSchemaVersion || version > MaxSchemaVersion {
+		return nil, &ErrUnsupportedVersion{Got: version, Min: MinSchemaVersion, Max: MaxSchemaVersion}
+	}
+	if err := VerifyFrame(buf); err != nil {
+		return nil, err
+	}
+	return state.GetRootAsFrame(buf, 0), nil
+}
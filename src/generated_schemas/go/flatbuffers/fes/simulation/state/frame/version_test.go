@@ -0,0 +1,61 @@
+package frame
+
+import (
+	"errors"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+func frameWithVersion(version uint16) []byte {
+	buf := sampleFrameBytes()
+	if !state.GetRootAsFrame(buf, 0).MutateSchemaVersion(version) {
+		panic("sample frame has no schema_version field")
+	}
+	return buf
+}
+
+func TestDecodeFrameCurrentVersion(t *testing.T) {
+	f, err := DecodeFrame(sampleFrameBytes())
+	if err != nil {
+		t.Fatalf("DecodeFrame: %v", err)
+	}
+	if f.SchemaVersion() != SchemaVersion || f.Tick() != 9 || f.EntitiesLength() != 3 {
+		t.Fatalf("decoded version %d, tick %d, %d entities", f.SchemaVersion(), f.Tick(), f.EntitiesLength())
+	}
+}
+
+func TestDecodeFrameUnsupportedVersion(t *testing.T) {
+	// A frame with no schema_version field at all predates versioning.
+	b := flatbuffers.NewBuilder(0)
+	state.FrameStart(b)
+	state.FrameAddTick(b, 1)
+	state.FinishFrameBuffer(b, state.FrameEnd(b))
+	unversioned := b.FinishedBytes()
+
+	for name, buf := range map[string][]byte{
+		"unversioned": unversioned,
+		"too old":     frameWithVersion(MinSchemaVersion - 1),
+		"too new":     frameWithVersion(MaxSchemaVersion + 1),
+	} {
+		f, err := DecodeFrame(buf)
+		var uv *ErrUnsupportedVersion
+		if !errors.As(err, &uv) || f != nil {
+			t.Errorf("%s: DecodeFrame = %v, %v, want *ErrUnsupportedVersion", name, f, err)
+			continue
+		}
+		if uv.Min != MinSchemaVersion || uv.Max != MaxSchemaVersion {
+			t.Errorf("%s: error range [%d, %d]", name, uv.Min, uv.Max)
+		}
+	}
+}
+
+func TestDecodeFrameVerifies(t *testing.T) {
+	buf := sampleFrameBytes()
+	var ve *VerifyError
+	if _, err := DecodeFrame(buf[:len(buf)-4]); !errors.As(err, &ve) {
+		t.Fatalf("DecodeFrame(truncated) = %v, want *VerifyError", err)
+	}
+}
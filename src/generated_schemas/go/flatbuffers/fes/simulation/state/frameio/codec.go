@@ -0,0 +1,235 @@
+// Package frameio reads and writes streams of serialized frames, such as
+// recorded simulation logs, and exports frames to CSV for analysis outside Go.
+// DoubleBuffer passes frames between goroutines within a process.
+//
+// A stream is a concatenation of records. Each record is a 4-byte
+// little-endian length followed by that many bytes of codec payload, so a
+// reader can split the stream without understanding the payload and can stop
+// and resume at any record boundary.
+//
+// Raw, gzip and zstd codecs are provided. Every codec refuses a record whose
+// length prefix, or whose payload once decompressed, exceeds
+// DefaultMaxFrameSize, so a corrupt or malicious record cannot exhaust memory.
+package frameio
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// headerSize is the size of a record's length prefix.
+const headerSize = 4
+
+// DefaultMaxFrameSize is the largest record, before or after decompression,
+// that the codecs will decode, and the limit NewFrameReader applies when
+// given a non-positive maximum. It is far above any realistic frame.
+const DefaultMaxFrameSize = 64 << 20
+
+// ErrFrameTooLarge is returned, wrapped with the offending size, when a
+// record exceeds the maximum frame size.
+var ErrFrameTooLarge = errors.New("frameio: frame exceeds maximum size")
+
+// Codec writes and reads one frame per call.
+type Codec interface {
+	// Encode writes buf to w as a single record.
+	Encode(w io.Writer, buf []byte) error
+	// Decode reads exactly one record from r and returns the frame it holds,
+	// leaving r positioned at the start of the next record. It returns io.EOF
+	// if r is already at the end of the stream, io.ErrUnexpectedEOF if the
+	// stream ends partway through a record, and an error wrapping
+	// ErrFrameTooLarge for a record over DefaultMaxFrameSize.
+	Decode(r io.Reader) ([]byte, error)
+}
+
+// Raw stores frames uncompressed.
+type Raw struct{}
+
+// Encode implements Codec.
+func (Raw) Encode(w io.Writer, buf []byte) error {
+	return writeRecord(w, buf)
+}
+
+// Decode implements Codec.
+func (Raw) Decode(r io.Reader) ([]byte, error) {
+	return readRecord(r, DefaultMaxFrameSize)
+}
+
+// Gzip compresses each frame as its own gzip member. Records are compressed
+// independently, so any record can be decoded without the ones before it.
+// Create one with NewGzip; a Gzip is safe for concurrent use.
+type Gzip struct {
+	level   int
+	maxSize int
+	writers sync.Pool
+	readers sync.Pool
+}
+
+// NewGzip returns a gzip codec at the given compress/gzip level. Decode
+// fails with ErrFrameTooLarge for a record that inflates past
+// DefaultMaxFrameSize.
+func NewGzip(level int) (*Gzip, error) {
+	if _, err := gzip.NewWriterLevel(io.Discard, level); err != nil {
+		return nil, err
+	}
+	return &Gzip{level: level, maxSize: DefaultMaxFrameSize}, nil
+}
+
+// Encode implements Codec.
+func (g *Gzip) Encode(w io.Writer, buf []byte) error {
+	var out bytes.Buffer
+	zw, ok := g.writers.Get().(*gzip.Writer)
+	if ok {
+		zw.Reset(&out)
+	} else {
+		// The level was validated by NewGzip.
+		zw, _ = gzip.NewWriterLevel(&out, g.level)
+	}
+	defer g.writers.Put(zw)
+
+	if _, err := zw.Write(buf); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return writeRecord(w, out.Bytes())
+}
+
+// Decode implements Codec.
+func (g *Gzip) Decode(r io.Reader) ([]byte, error) {
+	payload, err := readRecord(r, g.maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var zr *gzip.Reader
+	if pooled, ok := g.readers.Get().(*gzip.Reader); ok {
+		zr, err = pooled, pooled.Reset(bytes.NewReader(payload))
+	} else {
+		zr, err = gzip.NewReader(bytes.NewReader(payload))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("frameio: gzip record: %w", err)
+	}
+	defer g.readers.Put(zr)
+
+	buf, err := io.ReadAll(io.LimitReader(zr, int64(g.maxSize)+1))
+	if err != nil {
+		return nil, fmt.Errorf("frameio: gzip record: %w", err)
+	}
+	if len(buf) > g.maxSize {
+		return nil, fmt.Errorf("%w: gzip record inflates past %d bytes", ErrFrameTooLarge, g.maxSize)
+	}
+	return buf, nil
+}
+
+// Zstd compresses each frame as its own zstd frame, so like Gzip any record
+// can be decoded on its own. Create one with NewZstd; a Zstd is safe for
+// concurrent use. It holds encoder and decoder goroutines and buffers until
+// Close is called.
+type Zstd struct {
+	maxSize int
+	enc     *zstd.Encoder
+	dec     *zstd.Decoder
+}
+
+// NewZstd returns a zstd codec at the given level, on the zstd command's
+// scale of 1 (fastest) to 22 (smallest); the encoder rounds it to the nearest
+// level it implements. Decode fails with ErrFrameTooLarge for a record that
+// inflates past DefaultMaxFrameSize.
+func NewZstd(level int) (*Zstd, error) {
+	return newZstd(level, DefaultMaxFrameSize)
+}
+
+func newZstd(level, maxSize int) (*Zstd, error) {
+	enc, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	dec, err := zstd.NewReader(nil,
+		zstd.WithDecoderMaxMemory(uint64(maxSize)),
+		zstd.WithDecoderConcurrency(0))
+	if err != nil {
+		return nil, err
+	}
+	return &Zstd{maxSize: maxSize, enc: enc, dec: dec}, nil
+}
+
+// Close releases the encoder and decoder. The Zstd must not be used after
+// Close returns.
+func (z *Zstd) Close() error {
+	z.dec.Close()
+	return z.enc.Close()
+}
+
+// Encode implements Codec.
+func (z *Zstd) Encode(w io.Writer, buf []byte) error {
+	return writeRecord(w, z.enc.EncodeAll(buf, nil))
+}
+
+// Decode implements Codec.
+func (z *Zstd) Decode(r io.Reader) ([]byte, error) {
+	payload, err := readRecord(r, z.maxSize)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := z.dec.DecodeAll(payload, nil)
+	// A window larger than the memory limit is refused before any output is
+	// produced; it can only belong to a frame that inflates past the limit.
+	if errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) ||
+		(err == nil && len(buf) > z.maxSize) {
+		return nil, fmt.Errorf("%w: zstd record inflates past %d bytes", ErrFrameTooLarge, z.maxSize)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("frameio: zstd record: %w", err)
+	}
+	return buf, nil
+}
+
+func writeRecord(w io.Writer, payload []byte) error {
+	if uint64(len(payload)) > 1<<32-1 {
+		return fmt.Errorf("frameio: record of %d bytes exceeds the 4 GiB limit", len(payload))
+	}
+	var header [headerSize]byte
+	binary.LittleEndian.PutUint32(header[:], uint32(len(payload)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readRecord reads one length-prefixed payload of at most maxSize bytes. A
+// larger length is rejected before any of the payload is read. Within the
+// limit the payload is still read through a LimitReader rather than into a
+// buffer of the declared length, so a corrupt length cannot make it allocate
+// more than the stream holds.
+func readRecord(r io.Reader, maxSize int) ([]byte, error) {
+	var header [headerSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	n := int64(binary.LittleEndian.Uint32(header[:]))
+	if n > int64(maxSize) {
+		return nil, fmt.Errorf("%w: %d bytes, limit %d", ErrFrameTooLarge, n, maxSize)
+	}
+
+	var payload bytes.Buffer
+	read, err := io.Copy(&payload, io.LimitReader(r, n))
+	if err != nil {
+		return nil, err
+	}
+	if read < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return payload.Bytes(), nil
+}
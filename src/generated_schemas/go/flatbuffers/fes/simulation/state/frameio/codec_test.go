@@ -0,0 +1,295 @@
+package frameio
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"runtime"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/delta"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/sim/rng"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func codecs(t testing.TB) map[string]Codec {
+	gz, err := NewGzip(gzip.DefaultCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zs, err := NewZstd(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { zs.Close() })
+	return map[string]Codec{"raw": Raw{}, "gzip": gz, "zstd": zs}
+}
+
+// fishStream returns n serialized frames of a slow random walk of fish. Each
+// frame is the previous one with a delta applied, so positions sit on the
+// quantization grid exactly as a delta-decoded stream would deliver them.
+func fishStream(fish, n int) [][]byte {
+	frames, _ := fishWalk(fish, n)
+	return frames
+}
+
+// fishWalk returns the frames of fishStream together with the same walk as a
+// delta stream: the first frame in full, then one appendDelta record per tick.
+func fishWalk(fish, n int) (frames, deltas [][]byte) {
+	g := rng.New(1)
+	bounds := vecmath.AABB{Max: vecmath.Vec2f{X: 1000, Y: 1000}}
+	fb := frame.FrameBuilder{Tick: 1}
+	for i := range fish {
+		fb.Entities = append(fb.Entities, frame.Fish(frame.FishStateArgs{
+			ID:       uint32(i),
+			Position: g.PointInAABB(bounds),
+			Energy:   50,
+			Species:  state.SpeciesGuppy,
+		}))
+	}
+	prev := state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+	frames = [][]byte{prev.Table().Bytes}
+	deltas = [][]byte{prev.Table().Bytes}
+	for tick := uint64(2); len(frames) < n; tick++ {
+		for i := range fb.Entities {
+			step := vecmath.Scale(g.UnitVec2f(), 0.2)
+			fb.Entities[i].SetPosition(vecmath.Add(fb.Entities[i].Position(), step))
+		}
+		fb.Tick = tick
+		curr := state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+		d := delta.Encode(prev, curr)
+		prev = delta.Apply(prev, d)
+		frames = append(frames, prev.Table().Bytes)
+		deltas = append(deltas, appendDelta(nil, d))
+	}
+	return frames, deltas
+}
+
+// appendDelta serializes the moves of d as varints: the tick, the number of
+// moves, then each move's ID as a zigzag gap from the one before and its
+// zigzag DX and DY. It stands in for a delta wire format in benchmarks; the
+// walk never spawns or removes fish, so Added and Removed are not written.
+func appendDelta(dst []byte, d *delta.FrameDelta) []byte {
+	dst = binary.AppendUvarint(dst, d.Tick)
+	dst = binary.AppendUvarint(dst, uint64(len(d.Moved)))
+	var last int64
+	for _, m := range d.Moved {
+		dst = binary.AppendVarint(dst, int64(m.ID)-last)
+		dst = binary.AppendVarint(dst, int64(m.DX))
+		dst = binary.AppendVarint(dst, int64(m.DY))
+		last = int64(m.ID)
+	}
+	return dst
+}
+
+func TestCodecRoundTripStream(t *testing.T) {
+	frames := fishStream(50, 5)
+	frames = append(frames, []byte{})
+	for name, c := range codecs(t) {
+		t.Run(name, func(t *testing.T) {
+			var stream bytes.Buffer
+			for _, f := range frames {
+				if err := c.Encode(&stream, f); err != nil {
+					t.Fatalf("Encode: %v", err)
+				}
+			}
+			for i, want := range frames {
+				got, err := c.Decode(&stream)
+				if err != nil {
+					t.Fatalf("Decode frame %d: %v", i, err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Fatalf("frame %d differs after round trip", i)
+				}
+			}
+			if _, err := c.Decode(&stream); err != io.EOF {
+				t.Fatalf("Decode at end of stream = %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestCodecDecodeResumes(t *testing.T) {
+	frames := fishStream(10, 3)
+	for name, c := range codecs(t) {
+		t.Run(name, func(t *testing.T) {
+			var stream bytes.Buffer
+			for _, f := range frames {
+				if err := c.Encode(&stream, f); err != nil {
+					t.Fatal(err)
+				}
+			}
+			all := stream.Bytes()
+
+			// Decode the first record, then pick up from the remaining bytes
+			// with a fresh reader as a resumed read would.
+			r := bytes.NewReader(all)
+			if _, err := c.Decode(r); err != nil {
+				t.Fatal(err)
+			}
+			rest := all[len(all)-r.Len():]
+			got, err := c.Decode(bytes.NewReader(rest))
+			if err != nil || !bytes.Equal(got, frames[1]) {
+				t.Fatalf("resumed Decode = %v, frame matches: %v", err, bytes.Equal(got, frames[1]))
+			}
+		})
+	}
+}
+
+func TestCodecTruncated(t *testing.T) {
+	for name, c := range codecs(t) {
+		t.Run(name, func(t *testing.T) {
+			var stream bytes.Buffer
+			if err := c.Encode(&stream, fishStream(5, 1)[0]); err != nil {
+				t.Fatal(err)
+			}
+			whole := stream.Bytes()
+			for _, n := range []int{1, headerSize, len(whole) - 1} {
+				_, err := c.Decode(bytes.NewReader(whole[:n]))
+				if !errors.Is(err, io.ErrUnexpectedEOF) {
+					t.Errorf("truncated to %d bytes: err = %v, want io.ErrUnexpectedEOF", n, err)
+				}
+			}
+		})
+	}
+}
+
+func TestCodecRejectsOversizedLength(t *testing.T) {
+	for name, c := range codecs(t) {
+		for _, n := range []uint32{DefaultMaxFrameSize + 1, 0xffffffff} {
+			stream := binary.LittleEndian.AppendUint32(nil, n)
+			stream = append(stream, 1, 2, 3)
+			if _, err := c.Decode(bytes.NewReader(stream)); !errors.Is(err, ErrFrameTooLarge) {
+				t.Errorf("%s: length prefix %d: err = %v, want ErrFrameTooLarge", name, n, err)
+			}
+		}
+	}
+}
+
+func TestCodecHugeLengthDoesNotAllocate(t *testing.T) {
+	// A prefix just within the limit but far past the end of the stream must
+	// not allocate a buffer of the declared length.
+	stream := binary.LittleEndian.AppendUint32(nil, DefaultMaxFrameSize)
+	stream = append(stream, 1, 2, 3)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := Raw{}.Decode(bytes.NewReader(stream))
+	runtime.ReadMemStats(&after)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Decode = %v, want io.ErrUnexpectedEOF", err)
+	}
+	if n := after.TotalAlloc - before.TotalAlloc; n > 64<<10 {
+		t.Errorf("Decode with a %d-byte length allocated %d bytes", DefaultMaxFrameSize, n)
+	}
+}
+
+func TestCodecRejectsDecompressionBomb(t *testing.T) {
+	const limit = 1 << 16
+	gz, _ := NewGzip(gzip.BestSpeed)
+	gz.maxSize = limit
+	zs, err := newZstd(3, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zs.Close()
+
+	// Highly compressible payloads either side of the limit.
+	for name, c := range map[string]Codec{"gzip": gz, "zstd": zs} {
+		for _, size := range []int{limit, limit + 1, 16 << 20} {
+			var stream bytes.Buffer
+			if err := c.Encode(&stream, make([]byte, size)); err != nil {
+				t.Fatal(err)
+			}
+			buf, err := c.Decode(&stream)
+			if size <= limit {
+				if err != nil || len(buf) != size {
+					t.Errorf("%s: %d-byte record: %d bytes, %v", name, size, len(buf), err)
+				}
+			} else if !errors.Is(err, ErrFrameTooLarge) {
+				t.Errorf("%s: %d-byte record past a %d-byte limit: err = %v, want ErrFrameTooLarge", name, size, limit, err)
+			}
+		}
+	}
+}
+
+func TestNewGzipRejectsBadLevel(t *testing.T) {
+	if _, err := NewGzip(42); err == nil {
+		t.Fatal("NewGzip(42) succeeded")
+	}
+}
+
+// BenchmarkCodec10kFishStream reports throughput (MB/s of uncompressed record
+// data) and the compressed size as a fraction of the raw size, for the same
+// walk stored as full frames and as a delta stream.
+func BenchmarkCodec10kFishStream(b *testing.B) {
+	frames, deltas := fishWalk(10000, 20)
+
+	gzFast, _ := NewGzip(gzip.BestSpeed)
+	gzDefault, _ := NewGzip(gzip.DefaultCompression)
+	zsFast, _ := NewZstd(1)
+	defer zsFast.Close()
+	zsDefault, _ := NewZstd(3)
+	defer zsDefault.Close()
+	codecs := []struct {
+		name  string
+		codec Codec
+	}{
+		{"raw", Raw{}},
+		{"gzip-fast", gzFast},
+		{"gzip-default", gzDefault},
+		{"zstd-fast", zsFast},
+		{"zstd-default", zsDefault},
+	}
+
+	for _, st := range []struct {
+		name    string
+		records [][]byte
+	}{
+		{"frames", frames},
+		{"deltas", deltas},
+	} {
+		var total int
+		for _, rec := range st.records {
+			total += len(rec)
+		}
+		for _, bc := range codecs {
+			var stream bytes.Buffer
+			for _, rec := range st.records {
+				if err := bc.codec.Encode(&stream, rec); err != nil {
+					b.Fatal(err)
+				}
+			}
+			ratio := float64(stream.Len()) / float64(total)
+			encoded := stream.Bytes()
+
+			b.Run(st.name+"/"+bc.name+"/encode", func(b *testing.B) {
+				b.SetBytes(int64(total))
+				b.ReportMetric(ratio, "ratio")
+				for i := 0; i < b.N; i++ {
+					for _, rec := range st.records {
+						_ = bc.codec.Encode(io.Discard, rec)
+					}
+				}
+			})
+			b.Run(st.name+"/"+bc.name+"/decode", func(b *testing.B) {
+				b.SetBytes(int64(total))
+				b.ReportMetric(ratio, "ratio")
+				for i := 0; i < b.N; i++ {
+					r := bytes.NewReader(encoded)
+					for range st.records {
+						if _, err := bc.codec.Decode(r); err != nil {
+							b.Fatal(err)
+						}
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package frameio
+
+import (
+	"encoding/csv"
+	"errors"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func csvFrames() []*state.Frame {
+	build := func(tick uint64, entities ...frame.EntityArgs) *state.Frame {
+		fb := frame.FrameBuilder{Tick: tick, Entities: entities}
+		return state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+	}
+	return []*state.Frame{
+		build(1,
+			frame.Fish(frame.FishStateArgs{
+				ID:       7,
+				Position: vecmath.Vec2f{X: 1.5, Y: -2},
+				Velocity: vecmath.Vec2f{X: 0.25},
+				Energy:   10,
+				Species:  state.SpeciesPike,
+			}),
+			frame.Food(frame.FoodStateArgs{ID: 8, Position: vecmath.Vec2f{X: 3, Y: 4}}),
+		),
+		build(2, frame.Fish(frame.FishStateArgs{ID: 7, Species: state.Species(9)})),
+	}
+}
+
+func TestExportFramesCSV(t *testing.T) {
+	var out strings.Builder
+	if err := ExportFramesCSV(&out, slices.Values(csvFrames())); err != nil {
+		t.Fatalf("ExportFramesCSV: %v", err)
+	}
+	want := `tick,entity_id,kind,x,y,vx,vy,energy,species
+1,7,FishState,1.500000,-2.000000,0.250000,0.000000,10.000000,Pike
+1,8,FoodState,3.000000,4.000000,,,,
+2,7,FishState,0.000000,0.000000,0.000000,0.000000,0.000000,Species(9)
+`
+	if out.String() != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	for i, r := range rows {
+		if len(r) != len(csvHeader) {
+			t.Errorf("row %d has %d columns, want %d", i, len(r), len(csvHeader))
+		}
+	}
+}
+
+func TestExportFramesCSVEmpty(t *testing.T) {
+	var out strings.Builder
+	if err := ExportFramesCSV(&out, slices.Values([]*state.Frame(nil))); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != strings.Join(csvHeader, ",")+"\n" {
+		t.Fatalf("got %q, want only the header", out.String())
+	}
+}
+
+type failWriter struct{ err error }
+
+func (w failWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestExportFramesCSVPropagatesWriteError(t *testing.T) {
+	errDisk := errors.New("disk full")
+	pulled := 0
+	frames := func(yield func(*state.Frame) bool) {
+		for _, f := range csvFrames() {
+			pulled++
+			if !yield(f) {
+				return
+			}
+		}
+	}
+
+	err := ExportFramesCSV(failWriter{errDisk}, iter.Seq[*state.Frame](frames))
+	if !errors.Is(err, errDisk) {
+		t.Fatalf("err = %v, want %v", err, errDisk)
+	}
+	if pulled != 1 {
+		t.Errorf("pulled %d frames after the first write failed, want 1", pulled)
+	}
+}
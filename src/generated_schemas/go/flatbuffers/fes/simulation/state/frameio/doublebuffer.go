@@ -0,0 +1,78 @@
+package frameio
+
+import (
+	"sync/atomic"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// DoubleBuffer hands finished frames from one producer goroutine to one
+// consumer goroutine without locking, for a simulation that writes a frame
+// each tick while a renderer draws the latest one.
+//
+// The producer builds each frame into a buffer nobody else can see and then
+// publishes it with a single atomic swap, so the consumer only ever sees
+// complete frames. Buffers are recycled rather than reallocated: the consumer
+// gives its previous buffer back each time it picks up a new frame, and a
+// published frame the consumer skipped goes straight back to the producer.
+// Two buffers therefore suffice while the consumer keeps up; a third is
+// allocated only if the producer is about to publish over an unread frame
+// while the consumer still holds the one before it.
+//
+// WriteInto must only be called from one goroutine at a time, and Read from
+// one goroutine at a time; the two may run concurrently.
+type DoubleBuffer struct {
+	// ready is the newest published frame, or nil once the consumer has
+	// taken it.
+	ready atomic.Pointer[frameSlot]
+	// free holds a buffer the consumer has finished with, for the producer.
+	free atomic.Pointer[frameSlot]
+
+	spare *frameSlot // producer only
+	held  *frameSlot // consumer only
+}
+
+type frameSlot struct {
+	builder *flatbuffers.Builder
+	bytes   []byte
+}
+
+// WriteInto resets a back buffer's builder, calls build to write a frame into
+// it, and publishes the result. build must finish the buffer, for example
+// with state.FinishFrameBuffer or FrameBuilder.Finish.
+func (d *DoubleBuffer) WriteInto(build func(*flatbuffers.Builder)) {
+	s := d.spare
+	d.spare = nil
+	if s == nil {
+		s = d.free.Swap(nil)
+	}
+	if s == nil {
+		s = &frameSlot{builder: flatbuffers.NewBuilder(0)}
+	}
+
+	s.builder.Reset()
+	build(s.builder)
+	s.bytes = s.builder.FinishedBytes()
+
+	// A frame the consumer never picked up can be overwritten next time.
+	d.spare = d.ready.Swap(s)
+}
+
+// Read returns the newest frame published by WriteInto, or nil if none has
+// been published yet. If nothing new was published since the previous call,
+// the same frame is returned again.
+//
+// The returned slice stays valid, and is never written to, until the next
+// call to Read; the consumer must not retain it beyond that.
+func (d *DoubleBuffer) Read() []byte {
+	if s := d.ready.Swap(nil); s != nil {
+		if d.held != nil {
+			d.free.Store(d.held)
+		}
+		d.held = s
+	}
+	if d.held == nil {
+		return nil
+	}
+	return d.held.bytes
+}
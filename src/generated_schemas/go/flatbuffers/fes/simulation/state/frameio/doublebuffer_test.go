@@ -0,0 +1,121 @@
+package frameio
+
+import (
+	"sync"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// writeTick publishes a frame whose every fish sits at X == tick, so a frame
+// assembled from two different ticks is detectable.
+func writeTick(d *DoubleBuffer, tick uint64, fish int) {
+	d.WriteInto(func(b *flatbuffers.Builder) {
+		fb := frame.FrameBuilder{Tick: tick}
+		for i := range fish {
+			fb.Entities = append(fb.Entities, frame.Fish(frame.FishStateArgs{
+				ID:       uint32(i),
+				Position: vecmath.Vec2f{X: float32(tick), Y: float32(i)},
+			}))
+		}
+		fb.Finish(b)
+	})
+}
+
+func TestDoubleBufferReadLatest(t *testing.T) {
+	var d DoubleBuffer
+	if buf := d.Read(); buf != nil {
+		t.Fatalf("Read before any write = %d bytes, want nil", len(buf))
+	}
+	writeTick(&d, 1, 1)
+	writeTick(&d, 2, 1)
+	for range 2 {
+		if tick := state.GetRootAsFrame(d.Read(), 0).Tick(); tick != 2 {
+			t.Fatalf("Read tick = %d, want 2", tick)
+		}
+	}
+}
+
+func TestDoubleBufferReusesBuffers(t *testing.T) {
+	var d DoubleBuffer
+	seen := make(map[*frameSlot]struct{})
+	for tick := range uint64(50) {
+		writeTick(&d, tick, 4)
+		d.Read()
+		seen[d.held] = struct{}{}
+	}
+	if len(seen) != 2 {
+		t.Fatalf("lock-step producer and consumer used %d buffers, want 2", len(seen))
+	}
+}
+
+// TestDoubleBufferConcurrent is meant for go test -race.
+func TestDoubleBufferConcurrent(t *testing.T) {
+	const ticks, fish = 2000, 16
+	var d DoubleBuffer
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		for tick := uint64(1); tick <= ticks; tick++ {
+			writeTick(&d, tick, fish)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var last uint64
+		check := func() bool {
+			buf := d.Read()
+			if buf == nil {
+				return true
+			}
+			f, err := frame.DecodeFrame(buf)
+			if err != nil {
+				t.Errorf("read invalid frame: %v", err)
+				return false
+			}
+			if f.Tick() < last {
+				t.Errorf("tick went backwards: %d after %d", f.Tick(), last)
+				return false
+			}
+			last = f.Tick()
+			n := 0
+			for e := range frame.Entities(f) {
+				if e.Position().X != float32(f.Tick()) {
+					t.Errorf("tick %d frame holds fish %d from tick %v", f.Tick(), e.ID(), e.Position().X)
+					return false
+				}
+				n++
+			}
+			if n != fish {
+				t.Errorf("tick %d frame has %d fish, want %d", f.Tick(), n, fish)
+				return false
+			}
+			return true
+		}
+		for {
+			select {
+			case <-done:
+				check()
+				if last != ticks {
+					t.Errorf("final read saw tick %d, want %d", last, ticks)
+				}
+				return
+			default:
+				if !check() {
+					return
+				}
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,136 @@
+package frameio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+)
+
+// ErrLogClosed is returned by LogReader methods called after Close.
+var ErrLogClosed = errors.New("frameio: log reader is closed")
+
+// LogReader gives random access to the records of a log file too large to
+// load into memory, such as a multi-gigabyte recording. The file is memory
+// mapped, so only the pages actually read are paged in, and an index of
+// record offsets is built on open by walking the length prefixes.
+//
+// Records are returned as stored. For a log written with the Raw codec that
+// is the frame itself; other codecs' payloads still need decoding.
+//
+// FrameAt and CopyFrameAt may be called concurrently, but not concurrently
+// with Close.
+type LogReader struct {
+	data []byte
+	// starts holds the offset of each complete record's length prefix.
+	starts    []int
+	truncated int
+	unmap     func([]byte) error
+	closed    bool
+}
+
+// OpenLogReader maps the log file at path and indexes its records.
+//
+// A file that ends partway through a record, as happens when a recording is
+// cut off mid-write, is not an error: the incomplete tail is left out of the
+// index and reported by TrailingBytes. A corrupt length prefix anywhere is
+// indistinguishable from that, since the records after it cannot be found,
+// so indexing stops there too.
+func OpenLogReader(path string) (*LogReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > math.MaxInt {
+		return nil, fmt.Errorf("frameio: %s: %d bytes is too large to map", path, info.Size())
+	}
+	data, unmap, err := mapFile(f, int(info.Size()))
+	if err != nil {
+		return nil, fmt.Errorf("frameio: mapping %s: %w", path, err)
+	}
+
+	l := &LogReader{data: data, unmap: unmap}
+	l.index()
+	return l, nil
+}
+
+func (l *LogReader) index() {
+	at := 0
+	for len(l.data)-at >= headerSize {
+		end, ok := l.recordEnd(at)
+		if !ok {
+			break
+		}
+		l.starts = append(l.starts, at)
+		at = end
+	}
+	l.truncated = len(l.data) - at
+}
+
+// recordEnd returns the end of the record whose header starts at at, or
+// false if the record runs past the end of the data. The sum is taken in
+// uint64 so that a corrupt length cannot overflow int on 32-bit platforms.
+func (l *LogReader) recordEnd(at int) (int, bool) {
+	end := uint64(at) + headerSize + uint64(binary.LittleEndian.Uint32(l.data[at:]))
+	if end > uint64(len(l.data)) {
+		return 0, false
+	}
+	return int(end), true
+}
+
+// FrameCount returns the number of complete records in the log.
+func (l *LogReader) FrameCount() int {
+	return len(l.starts)
+}
+
+// TrailingBytes returns the number of bytes at the end of the file that did
+// not form a complete record and so were left out of the index. It is 0 for
+// a cleanly finished log.
+func (l *LogReader) TrailingBytes() int {
+	return l.truncated
+}
+
+// FrameAt returns record i without copying it. The slice is a view of the
+// mapped file: it must not be modified, and it is valid only until Close,
+// after which reading it faults. Use CopyFrameAt for a frame that has to
+// outlive the reader.
+func (l *LogReader) FrameAt(i int) ([]byte, error) {
+	if l.closed {
+		return nil, ErrLogClosed
+	}
+	if i < 0 || i >= len(l.starts) {
+		return nil, fmt.Errorf("frameio: frame %d out of range [0, %d)", i, len(l.starts))
+	}
+	at := l.starts[i]
+	end, _ := l.recordEnd(at) // in bounds: index checked every record it kept
+	return l.data[at+headerSize : end : end], nil
+}
+
+// CopyFrameAt is FrameAt returning a copy of the record, which stays valid
+// after Close.
+func (l *LogReader) CopyFrameAt(i int) ([]byte, error) {
+	buf, err := l.FrameAt(i)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), buf...), nil
+}
+
+// Close unmaps the file. Every slice returned by FrameAt becomes invalid.
+// Calling Close again returns ErrLogClosed.
+func (l *LogReader) Close() error {
+	if l.closed {
+		return ErrLogClosed
+	}
+	l.closed = true
+	data := l.data
+	l.data, l.starts = nil, nil
+	return l.unmap(data)
+}
@@ -0,0 +1,171 @@
+package frameio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+)
+
+// writeLog writes frames to a new Raw log file and returns its path and
+// contents.
+func writeLog(t *testing.T, frames [][]byte) (string, []byte) {
+	t.Helper()
+	var log bytes.Buffer
+	for _, f := range frames {
+		if err := (Raw{}).Encode(&log, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "run.log")
+	if err := os.WriteFile(path, log.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path, log.Bytes()
+}
+
+func openLog(t *testing.T, path string) *LogReader {
+	t.Helper()
+	l, err := OpenLogReader(path)
+	if err != nil {
+		t.Fatalf("OpenLogReader: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return l
+}
+
+func TestLogReaderRandomAccess(t *testing.T) {
+	frames := fishStream(50, 40)
+	path, _ := writeLog(t, frames)
+	l := openLog(t, path)
+
+	if n := l.FrameCount(); n != len(frames) {
+		t.Fatalf("FrameCount = %d, want %d", n, len(frames))
+	}
+	if n := l.TrailingBytes(); n != 0 {
+		t.Errorf("TrailingBytes = %d, want 0", n)
+	}
+	for _, i := range []int{39, 0, 17, 17, 1, 38} {
+		buf, err := l.FrameAt(i)
+		if err != nil {
+			t.Fatalf("FrameAt(%d): %v", i, err)
+		}
+		if !bytes.Equal(buf, frames[i]) {
+			t.Fatalf("FrameAt(%d) differs from the frame written", i)
+		}
+		if _, err := frame.DecodeFrame(buf); err != nil {
+			t.Fatalf("FrameAt(%d) does not decode: %v", i, err)
+		}
+	}
+	for _, i := range []int{-1, len(frames)} {
+		if _, err := l.FrameAt(i); err == nil {
+			t.Errorf("FrameAt(%d) succeeded", i)
+		}
+	}
+}
+
+func TestLogReaderTruncatedTail(t *testing.T) {
+	frames := fishStream(10, 5)
+	_, full := writeLog(t, frames)
+	last := headerSize + len(frames[4])
+
+	tests := []struct {
+		name     string
+		cut      int // bytes removed from the end
+		count    int
+		trailing int
+	}{
+		{"mid payload", 7, 4, last - 7},
+		{"mid header", last - 2, 4, 2},
+		{"at boundary", last, 4, 0},
+		{"everything", len(full), 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cut.log")
+			if err := os.WriteFile(path, full[:len(full)-tt.cut], 0o644); err != nil {
+				t.Fatal(err)
+			}
+			l := openLog(t, path)
+			if l.FrameCount() != tt.count || l.TrailingBytes() != tt.trailing {
+				t.Fatalf("FrameCount, TrailingBytes = %d, %d; want %d, %d",
+					l.FrameCount(), l.TrailingBytes(), tt.count, tt.trailing)
+			}
+			for i := range tt.count {
+				if buf, err := l.FrameAt(i); err != nil || !bytes.Equal(buf, frames[i]) {
+					t.Fatalf("FrameAt(%d) = %d bytes, %v", i, len(buf), err)
+				}
+			}
+		})
+	}
+}
+
+func TestLogReaderCorruptLength(t *testing.T) {
+	frames := fishStream(10, 3)
+	_, log := writeLog(t, frames)
+	at := headerSize + len(frames[0])
+
+	// Make the second record claim more bytes than the file holds. The
+	// larger prefixes would be negative lengths as a 32-bit int.
+	for _, n := range []uint32{uint32(len(log)), 1 << 31, 0xffffffff} {
+		corrupt := slices.Clone(log)
+		binary.LittleEndian.PutUint32(corrupt[at:], n)
+		path := filepath.Join(t.TempDir(), "corrupt.log")
+		if err := os.WriteFile(path, corrupt, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		l := openLog(t, path)
+		if l.FrameCount() != 1 || l.TrailingBytes() != len(log)-at {
+			t.Errorf("prefix %#x: FrameCount, TrailingBytes = %d, %d; want 1, %d", n, l.FrameCount(), l.TrailingBytes(), len(log)-at)
+		}
+	}
+}
+
+func TestLogReaderClose(t *testing.T) {
+	frames := fishStream(10, 3)
+	path, _ := writeLog(t, frames)
+	l, err := OpenLogReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kept, err := l.CopyFrameAt(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if !bytes.Equal(kept, frames[2]) {
+		t.Error("copied frame changed after Close")
+	}
+	if _, err := l.FrameAt(0); !errors.Is(err, ErrLogClosed) {
+		t.Errorf("FrameAt after Close = %v, want ErrLogClosed", err)
+	}
+	if err := l.Close(); !errors.Is(err, ErrLogClosed) {
+		t.Errorf("second Close = %v, want ErrLogClosed", err)
+	}
+}
+
+func TestLogReaderEmptyFile(t *testing.T) {
+	path, _ := writeLog(t, nil)
+	l, err := OpenLogReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.FrameCount() != 0 || l.TrailingBytes() != 0 {
+		t.Errorf("empty log: FrameCount %d, TrailingBytes %d", l.FrameCount(), l.TrailingBytes())
+	}
+	if err := l.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if _, err := OpenLogReader(filepath.Join(t.TempDir(), "missing.log")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("OpenLogReader(missing) = %v, want ErrNotExist", err)
+	}
+}
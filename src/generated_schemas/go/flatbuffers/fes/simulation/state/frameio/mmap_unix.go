@@ -0,0 +1,22 @@
+//go:build unix
+
+package frameio
+
+import (
+	"os"
+	"syscall"
+)
+
+// mapFile maps the first size bytes of f read-only and returns them with the
+// function that unmaps them. The mapping outlives f being closed.
+func mapFile(f *os.File, size int) ([]byte, func([]byte) error, error) {
+	if size == 0 {
+		// mmap rejects empty mappings.
+		return nil, func([]byte) error { return nil }, nil
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, syscall.Munmap, nil
+}
@@ -0,0 +1,72 @@
+package frameio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// FrameReader splits a stream of length-prefixed records, as written by the
+// Raw codec, into frames. It is meant for connections such as a net.Conn
+// whose Read calls return arbitrary fragments: both the length prefix and the
+// body may arrive split across any number of reads.
+//
+// A FrameReader is not safe for concurrent use.
+type FrameReader struct {
+	r       io.Reader
+	maxSize int
+	buf     []byte
+	err     error
+}
+
+// NewFrameReader returns a reader of records from r no larger than
+// maxFrameSize bytes. A maxFrameSize <= 0 means DefaultMaxFrameSize.
+func NewFrameReader(r io.Reader, maxFrameSize int) *FrameReader {
+	if maxFrameSize <= 0 {
+		maxFrameSize = DefaultMaxFrameSize
+	}
+	return &FrameReader{r: r, maxSize: maxFrameSize}
+}
+
+// Next returns the next complete frame. The returned slice is only valid
+// until the following call to Next, which reuses its storage.
+//
+// At a clean end of stream, between records, Next returns io.EOF. A stream
+// that ends partway through a record returns io.ErrUnexpectedEOF, and a
+// length prefix larger than the maximum returns an error wrapping
+// ErrFrameTooLarge before any body is read or allocated. The position in the
+// stream is lost after any error, so every later call returns the same one.
+func (fr *FrameReader) Next() ([]byte, error) {
+	if fr.err != nil {
+		return nil, fr.err
+	}
+	frame, err := fr.next()
+	if err != nil {
+		fr.err = err
+		return nil, err
+	}
+	return frame, nil
+}
+
+func (fr *FrameReader) next() ([]byte, error) {
+	var header [headerSize]byte
+	if _, err := io.ReadFull(fr.r, header[:]); err != nil {
+		return nil, err
+	}
+	n := binary.LittleEndian.Uint32(header[:])
+	if uint64(n) > uint64(fr.maxSize) {
+		return nil, fmt.Errorf("%w: %d bytes, limit %d", ErrFrameTooLarge, n, fr.maxSize)
+	}
+
+	if cap(fr.buf) < int(n) {
+		fr.buf = make([]byte, n)
+	}
+	fr.buf = fr.buf[:n]
+	if _, err := io.ReadFull(fr.r, fr.buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return fr.buf, nil
+}
@@ -0,0 +1,136 @@
+package frameio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// chunkReader returns at most the next size from sizes bytes per Read,
+// cycling through sizes, to simulate a connection fragmenting its data.
+type chunkReader struct {
+	data  []byte
+	sizes []byte
+	i     int
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	if len(c.data) == 0 {
+		return 0, io.EOF
+	}
+	n := 1
+	if len(c.sizes) > 0 {
+		n = int(c.sizes[c.i%len(c.sizes)])%16 + 1
+		c.i++
+	}
+	n = min(n, len(p), len(c.data))
+	copy(p, c.data[:n])
+	c.data = c.data[n:]
+	return n, nil
+}
+
+func rawStream(t testing.TB, frames ...[]byte) []byte {
+	var buf bytes.Buffer
+	for _, f := range frames {
+		if err := (Raw{}).Encode(&buf, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestFrameReaderReassemblesFragments(t *testing.T) {
+	frames := [][]byte{[]byte("hello"), {}, bytes.Repeat([]byte{7}, 300)}
+	stream := rawStream(t, frames...)
+
+	for name, r := range map[string]io.Reader{
+		"whole":    bytes.NewReader(stream),
+		"one byte": iotest.OneByteReader(bytes.NewReader(stream)),
+		"chunks":   &chunkReader{data: stream, sizes: []byte{2, 5, 0, 11}},
+	} {
+		fr := NewFrameReader(r, 0)
+		for i, want := range frames {
+			got, err := fr.Next()
+			if err != nil || !bytes.Equal(got, want) {
+				t.Fatalf("%s: frame %d = %q, %v, want %q", name, i, got, err, want)
+			}
+		}
+		if _, err := fr.Next(); err != io.EOF {
+			t.Fatalf("%s: Next at end = %v, want io.EOF", name, err)
+		}
+	}
+}
+
+func TestFrameReaderTruncated(t *testing.T) {
+	stream := rawStream(t, []byte("hello"))
+	for _, n := range []int{2, headerSize, len(stream) - 1} {
+		fr := NewFrameReader(iotest.OneByteReader(bytes.NewReader(stream[:n])), 0)
+		if _, err := fr.Next(); err != io.ErrUnexpectedEOF {
+			t.Errorf("truncated to %d: err = %v, want io.ErrUnexpectedEOF", n, err)
+		}
+	}
+}
+
+func TestFrameReaderRejectsHugeLength(t *testing.T) {
+	stream := []byte{0xff, 0xff, 0xff, 0xff, 1, 2, 3}
+	fr := NewFrameReader(bytes.NewReader(stream), 1024)
+	frame, err := fr.Next()
+	if !errors.Is(err, ErrFrameTooLarge) || frame != nil {
+		t.Fatalf("Next = %v, %v, want ErrFrameTooLarge", frame, err)
+	}
+	if cap(fr.buf) != 0 {
+		t.Fatalf("reader allocated %d bytes for a rejected frame", cap(fr.buf))
+	}
+	if _, again := fr.Next(); !errors.Is(again, ErrFrameTooLarge) {
+		t.Fatalf("second Next = %v, want the same error", again)
+	}
+
+	exact := rawStream(t, make([]byte, 1024))
+	if _, err := NewFrameReader(bytes.NewReader(exact), 1024).Next(); err != nil {
+		t.Fatalf("frame of exactly the maximum size: %v", err)
+	}
+}
+
+// FuzzFrameReader checks that chunk boundaries never change what is read:
+// any fragmentation of a stream yields the same frames and final error as
+// reading it whole.
+func FuzzFrameReader(f *testing.F) {
+	f.Add(rawStream(f, []byte("abc"), []byte("defgh")), []byte{1, 3, 2})
+	f.Add(rawStream(f, make([]byte, 40)), []byte{0})
+	f.Add([]byte{0xff, 0xff, 0, 0, 9}, []byte{4})
+	f.Add([]byte{}, []byte{})
+
+	const maxSize = 256
+	readAll := func(r io.Reader) ([][]byte, error) {
+		fr := NewFrameReader(r, maxSize)
+		var out [][]byte
+		for {
+			frame, err := fr.Next()
+			if err != nil {
+				return out, err
+			}
+			if len(frame) > maxSize {
+				panic("frame larger than the maximum")
+			}
+			out = append(out, bytes.Clone(frame))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, stream, sizes []byte) {
+		want, wantErr := readAll(bytes.NewReader(stream))
+		got, gotErr := readAll(&chunkReader{data: stream, sizes: sizes})
+		if len(got) != len(want) {
+			t.Fatalf("chunked read returned %d frames, whole read %d", len(got), len(want))
+		}
+		for i := range want {
+			if !bytes.Equal(got[i], want[i]) {
+				t.Fatalf("frame %d differs between chunked and whole reads", i)
+			}
+		}
+		if gotErr.Error() != wantErr.Error() {
+			t.Fatalf("chunked read ended with %v, whole read with %v", gotErr, wantErr)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package grid
+
+import (
+	"math"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// Vec2i is a grid cell coordinate held by value, the plain-Go counterpart of
+// the generated state.Vec2i accessor.
+type Vec2i struct {
+	X, Y int32
+}
+
+// Vec2iFromFB copies a FlatBuffers Vec2i into a value. A nil accessor yields
+// cell (0, 0).
+func Vec2iFromFB(v *state.Vec2i) Vec2i {
+	if v == nil {
+		return Vec2i{}
+	}
+	return Vec2i{X: v.X(), Y: v.Y()}
+}
+
+// ManhattanDistance returns the number of orthogonal steps between cells a
+// and b, |dx| + |dy|.
+//
+// The sum is computed in int64 and saturates at math.MaxInt32 rather than
+// wrapping, so cells at opposite ends of the int32 range still compare as
+// far apart.
+func ManhattanDistance(a, b Vec2i) int32 {
+	dx, dy := absDiff(a.X, b.X), absDiff(a.Y, b.Y)
+	return saturate(dx + dy)
+}
+
+// ChebyshevDistance returns the number of king's-move steps (orthogonal or
+// diagonal) between cells a and b, max(|dx|, |dy|). Like ManhattanDistance it
+// saturates at math.MaxInt32.
+func ChebyshevDistance(a, b Vec2i) int32 {
+	return saturate(max(absDiff(a.X, b.X), absDiff(a.Y, b.Y)))
+}
+
+func absDiff(a, b int32) int64 {
+	d := int64(a) - int64(b)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func saturate(d int64) int32 {
+	return int32(min(d, math.MaxInt32))
+}
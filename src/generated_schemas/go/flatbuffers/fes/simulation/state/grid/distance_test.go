@@ -0,0 +1,47 @@
+package grid
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGridDistances(t *testing.T) {
+	tests := []struct {
+		a, b      Vec2i
+		manhattan int32
+		chebyshev int32
+	}{
+		{Vec2i{0, 0}, Vec2i{0, 0}, 0, 0},
+		{Vec2i{1, 2}, Vec2i{4, 6}, 7, 4},
+		{Vec2i{-1, 2}, Vec2i{-4, 6}, 7, 4},
+		{Vec2i{-1, -2}, Vec2i{-4, -6}, 7, 4},
+		{Vec2i{1, -2}, Vec2i{4, -6}, 7, 4},
+		{Vec2i{-3, -3}, Vec2i{2, 4}, 12, 7},
+		{Vec2i{5, 0}, Vec2i{-5, 0}, 10, 10},
+	}
+	for _, tt := range tests {
+		if got := ManhattanDistance(tt.a, tt.b); got != tt.manhattan {
+			t.Errorf("ManhattanDistance(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.manhattan)
+		}
+		if got := ManhattanDistance(tt.b, tt.a); got != tt.manhattan {
+			t.Errorf("ManhattanDistance is not symmetric for %v, %v", tt.a, tt.b)
+		}
+		if got := ChebyshevDistance(tt.a, tt.b); got != tt.chebyshev {
+			t.Errorf("ChebyshevDistance(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.chebyshev)
+		}
+	}
+}
+
+func TestGridDistancesSaturate(t *testing.T) {
+	lo := Vec2i{math.MinInt32, math.MinInt32}
+	hi := Vec2i{math.MaxInt32, math.MaxInt32}
+	if got := ManhattanDistance(lo, hi); got != math.MaxInt32 {
+		t.Errorf("ManhattanDistance across the range = %d, want MaxInt32", got)
+	}
+	if got := ChebyshevDistance(lo, hi); got != math.MaxInt32 {
+		t.Errorf("ChebyshevDistance across the range = %d, want MaxInt32", got)
+	}
+	if got := ManhattanDistance(Vec2i{X: math.MaxInt32 - 1}, Vec2i{X: -1}); got != math.MaxInt32 {
+		t.Errorf("ManhattanDistance just past the limit = %d, want MaxInt32", got)
+	}
+}
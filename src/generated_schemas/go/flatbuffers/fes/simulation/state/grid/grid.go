@@ -0,0 +1,70 @@
+// Package grid maps continuous world positions onto the discrete tile grid
+// used for spatial partitioning.
+//
+// Cells are square with side cellSize and cell (0, 0) covers
+// [0, cellSize) on both axes, or [origin, origin+cellSize) for the Offset
+// variants. cellSize must be positive.
+package grid
+
+import (
+	"math"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// Vec2fToCell returns the grid cell containing v.
+//
+// Coordinates are floored toward negative infinity rather than truncated
+// toward zero, so -0.5 lands in cell -1 and not cell 0. Truncation would fold
+// the two cells either side of the origin into one, double-counting it in
+// worlds that span negative coordinates. Results outside the int32 range are
+// clamped to its bounds.
+func Vec2fToCell(v *state.Vec2f, cellSize float32) (cx, cy int32) {
+	return floorCell(v.X(), 0, cellSize), floorCell(v.Y(), 0, cellSize)
+}
+
+// CellOf is Vec2fToCell for a position held by value.
+func CellOf(v vecmath.Vec2f, cellSize float32) (cx, cy int32) {
+	return floorCell(v.X, 0, cellSize), floorCell(v.Y, 0, cellSize)
+}
+
+// Vec2fToCellOffset is Vec2fToCell for a grid whose cell (0, 0) starts at
+// origin rather than at the world origin, as when cell boundaries are lined
+// up with the aquarium décor. A position exactly on a boundary lands in the
+// higher cell, as it does with a zero origin. Vec2fToCell is the case
+// origin == Vec2f{}.
+func Vec2fToCellOffset(v *state.Vec2f, cellSize float32, origin vecmath.Vec2f) (cx, cy int32) {
+	return floorCell(v.X(), origin.X, cellSize), floorCell(v.Y(), origin.Y, cellSize)
+}
+
+// CellCenter returns the world position of the centre of cell (cx, cy). It is
+// the inverse of Vec2fToCell for any point inside the cell.
+func CellCenter(cx, cy int32, cellSize float32) (x, y float32) {
+	return cellCenter(cx, 0, cellSize), cellCenter(cy, 0, cellSize)
+}
+
+// CellCenterOffset is the inverse of Vec2fToCellOffset: the world position of
+// the centre of cell (cx, cy) in a grid whose cell (0, 0) starts at origin.
+func CellCenterOffset(cx, cy int32, cellSize float32, origin vecmath.Vec2f) (x, y float32) {
+	return cellCenter(cx, origin.X, cellSize), cellCenter(cy, origin.Y, cellSize)
+}
+
+// floorCell works in float64 so that c-origin is exact and a point on a
+// boundary floors to the boundary's cell instead of rounding below it.
+func floorCell(c, origin, cellSize float32) int32 {
+	f := math.Floor((float64(c) - float64(origin)) / float64(cellSize))
+	switch {
+	case f >= math.MaxInt32:
+		return math.MaxInt32
+	case f <= math.MinInt32:
+		return math.MinInt32
+	case f != f: // NaN
+		return 0
+	}
+	return int32(f)
+}
+
+func cellCenter(c int32, origin, cellSize float32) float32 {
+	return float32(float64(origin) + (float64(c)+0.5)*float64(cellSize))
+}
@@ -0,0 +1,112 @@
+package grid
+
+import (
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func newVec2f(x, y float32) *state.Vec2f {
+	b := flatbuffers.NewBuilder(0)
+	b.Finish(state.CreateVec2f(b, x, y))
+	buf := b.FinishedBytes()
+	v := &state.Vec2f{}
+	v.Init(buf, flatbuffers.GetUOffsetT(buf))
+	return v
+}
+
+func TestVec2fToCell(t *testing.T) {
+	tests := []struct {
+		x, y     float32
+		cellSize float32
+		cx, cy   int32
+	}{
+		{0, 0, 1, 0, 0},
+		{0.5, 0.5, 1, 0, 0},
+		{-0.5, -0.5, 1, -1, -1},
+		{-0.5, 0.5, 1, -1, 0},
+		{-1, -1, 1, -1, -1},
+		{-1.01, 2.99, 1, -2, 2},
+		{25, -25, 10, 2, -3},
+		{1e30, -1e30, 1, 2147483647, -2147483648},
+	}
+	for _, tt := range tests {
+		cx, cy := Vec2fToCell(newVec2f(tt.x, tt.y), tt.cellSize)
+		if cx != tt.cx || cy != tt.cy {
+			t.Errorf("Vec2fToCell(%v, %v, %v) = (%d, %d), want (%d, %d)",
+				tt.x, tt.y, tt.cellSize, cx, cy, tt.cx, tt.cy)
+		}
+		if vx, vy := CellOf(vecmath.Vec2f{X: tt.x, Y: tt.y}, tt.cellSize); vx != cx || vy != cy {
+			t.Errorf("CellOf(%v, %v, %v) = (%d, %d), disagrees with Vec2fToCell", tt.x, tt.y, tt.cellSize, vx, vy)
+		}
+	}
+}
+
+func TestCellCenterRoundTrip(t *testing.T) {
+	const cellSize = 2.5
+	for cx := int32(-3); cx <= 3; cx++ {
+		for cy := int32(-3); cy <= 3; cy++ {
+			x, y := CellCenter(cx, cy, cellSize)
+			gx, gy := Vec2fToCell(newVec2f(x, y), cellSize)
+			if gx != cx || gy != cy {
+				t.Errorf("CellCenter(%d, %d) = (%v, %v) maps back to (%d, %d)", cx, cy, x, y, gx, gy)
+			}
+		}
+	}
+
+	if x, y := CellCenter(-1, 0, 1); x != -0.5 || y != 0.5 {
+		t.Errorf("CellCenter(-1, 0, 1) = (%v, %v), want (-0.5, 0.5)", x, y)
+	}
+}
+
+func TestVec2fToCellOffset(t *testing.T) {
+	origin := vecmath.Vec2f{X: 0.25, Y: -1.75}
+	tests := []struct {
+		x, y   float32
+		cx, cy int32
+	}{
+		{0.25, -1.75, 0, 0},   // origin itself
+		{0.24, -1.76, -1, -1}, // just below it
+		{1.75, -0.25, 1, 1},   // exactly one cell up: the higher cell
+		{1.74, -0.26, 0, 0},
+		{-1.25, -3.25, -1, -1}, // exactly one cell down
+		{0, 0, -1, 1},
+	}
+	for _, tt := range tests {
+		cx, cy := Vec2fToCellOffset(newVec2f(tt.x, tt.y), 1.5, origin)
+		if cx != tt.cx || cy != tt.cy {
+			t.Errorf("Vec2fToCellOffset(%v, %v) = (%d, %d), want (%d, %d)", tt.x, tt.y, cx, cy, tt.cx, tt.cy)
+		}
+	}
+
+	for _, v := range []struct{ x, y float32 }{{0, 0}, {-0.5, 3.7}, {1e30, -1e30}} {
+		ax, ay := Vec2fToCellOffset(newVec2f(v.x, v.y), 2, vecmath.Vec2f{})
+		bx, by := Vec2fToCell(newVec2f(v.x, v.y), 2)
+		if ax != bx || ay != by {
+			t.Errorf("zero origin at (%v, %v): (%d, %d), Vec2fToCell gives (%d, %d)", v.x, v.y, ax, ay, bx, by)
+		}
+	}
+}
+
+func TestCellCenterOffsetRoundTrip(t *testing.T) {
+	const cellSize = 0.7
+	for _, origin := range []vecmath.Vec2f{{X: 0.1, Y: -0.35}, {X: -12.3, Y: 4.05}} {
+		for cx := int32(-3); cx <= 3; cx++ {
+			for cy := int32(-3); cy <= 3; cy++ {
+				x, y := CellCenterOffset(cx, cy, cellSize, origin)
+				gx, gy := Vec2fToCellOffset(newVec2f(x, y), cellSize, origin)
+				if gx != cx || gy != cy {
+					t.Errorf("origin %v: CellCenterOffset(%d, %d) = (%v, %v) maps back to (%d, %d)",
+						origin, cx, cy, x, y, gx, gy)
+				}
+			}
+		}
+	}
+
+	if x, y := CellCenterOffset(0, -1, 1, vecmath.Vec2f{X: 0.25, Y: 0.5}); x != 0.75 || y != 0 {
+		t.Errorf("CellCenterOffset(0, -1) = (%v, %v), want (0.75, 0)", x, y)
+	}
+}
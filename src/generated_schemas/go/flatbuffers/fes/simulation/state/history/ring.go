@@ -0,0 +1,111 @@
+// Package history keeps recent serialized frames in memory so that tools can
+// scrub backward through simulation state.
+package history
+
+import (
+	"bytes"
+	"cmp"
+	"iter"
+	"slices"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+)
+
+type slot struct {
+	tick uint64
+	buf  []byte
+}
+
+// Ring holds the most recent frames up to a fixed capacity, evicting the
+// oldest pushed frame once full. Frames are looked up by the tick recorded in
+// each frame rather than by insertion order.
+//
+// A Ring is not safe for concurrent use.
+type Ring struct {
+	slots  []slot
+	next   int // slot the next Push writes to
+	size   int
+	byTick map[uint64]int
+}
+
+// NewRing returns an empty ring holding at most capacity frames. It panics if
+// capacity is less than one.
+func NewRing(capacity int) *Ring {
+	if capacity < 1 {
+		panic("history: ring capacity must be at least 1")
+	}
+	return &Ring{
+		slots:  make([]slot, capacity),
+		byTick: make(map[uint64]int, capacity),
+	}
+}
+
+// Len returns the number of frames currently held.
+func (r *Ring) Len() int {
+	return r.size
+}
+
+// Cap returns the maximum number of frames the ring holds.
+func (r *Ring) Cap() int {
+	return len(r.slots)
+}
+
+// Push stores a copy of the serialized frame buf, so the caller may reuse buf
+// afterwards. If the ring is full the oldest frame is evicted. A frame with
+// the same tick as one already held replaces it for lookups.
+//
+// buf is verified before its tick is read; an invalid frame is rejected with
+// the error from frame.VerifyFrame and the ring is left unchanged.
+func (r *Ring) Push(buf []byte) error {
+	if err := frame.VerifyFrame(buf); err != nil {
+		return err
+	}
+	tick := state.GetRootAsFrame(buf, 0).Tick()
+
+	i := r.next
+	if r.size == len(r.slots) {
+		old := r.slots[i].tick
+		if r.byTick[old] == i {
+			delete(r.byTick, old)
+		}
+	} else {
+		r.size++
+	}
+	r.slots[i] = slot{tick: tick, buf: bytes.Clone(buf)}
+	r.byTick[tick] = i
+	r.next = (i + 1) % len(r.slots)
+	return nil
+}
+
+// At returns the frame recorded at tick, or false if no such frame was pushed
+// or it has already been evicted. The returned slice is owned by the ring and
+// must not be modified.
+func (r *Ring) At(tick uint64) ([]byte, bool) {
+	i, ok := r.byTick[tick]
+	if !ok {
+		return nil, false
+	}
+	return r.slots[i].buf, true
+}
+
+// Range yields the held frames whose tick lies in [fromTick, toTick], in
+// ascending tick order. Frames pushed or evicted during iteration may or may
+// not be seen.
+func (r *Ring) Range(fromTick, toTick uint64) iter.Seq[[]byte] {
+	return func(yield func([]byte) bool) {
+		var ticks []uint64
+		for tick := range r.byTick {
+			if tick >= fromTick && tick <= toTick {
+				ticks = append(ticks, tick)
+			}
+		}
+		slices.SortFunc(ticks, cmp.Compare)
+		for _, tick := range ticks {
+			buf, ok := r.At(tick)
+			if ok && !yield(buf) {
+				return
+			}
+		}
+	}
+}
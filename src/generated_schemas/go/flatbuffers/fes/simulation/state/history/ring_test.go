@@ -0,0 +1,107 @@
+package history
+
+import (
+	"slices"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+)
+
+func frameBytes(tick uint64) []byte {
+	fb := frame.FrameBuilder{Tick: tick, Entities: []frame.EntityArgs{frame.Fish(frame.FishStateArgs{ID: uint32(tick)})}}
+	return fb.Finish(flatbuffers.NewBuilder(0))
+}
+
+func tickOf(buf []byte) uint64 {
+	return state.GetRootAsFrame(buf, 0).Tick()
+}
+
+func collect(r *Ring, from, to uint64) []uint64 {
+	var ticks []uint64
+	for buf := range r.Range(from, to) {
+		ticks = append(ticks, tickOf(buf))
+	}
+	return ticks
+}
+
+func TestRingWraparoundAndEviction(t *testing.T) {
+	r := NewRing(3)
+	for tick := uint64(10); tick < 15; tick++ {
+		if err := r.Push(frameBytes(tick)); err != nil {
+			t.Fatalf("Push(%d): %v", tick, err)
+		}
+	}
+	if r.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", r.Len())
+	}
+
+	for _, tick := range []uint64{10, 11} {
+		if _, ok := r.At(tick); ok {
+			t.Errorf("At(%d) found an evicted frame", tick)
+		}
+	}
+	for _, tick := range []uint64{12, 13, 14} {
+		buf, ok := r.At(tick)
+		if !ok || tickOf(buf) != tick {
+			t.Errorf("At(%d) = %v", tick, ok)
+		}
+	}
+	if _, ok := r.At(99); ok {
+		t.Error("At found a tick never pushed")
+	}
+
+	if got := collect(r, 0, 100); !slices.Equal(got, []uint64{12, 13, 14}) {
+		t.Errorf("Range(all) = %v", got)
+	}
+	if got := collect(r, 13, 13); !slices.Equal(got, []uint64{13}) {
+		t.Errorf("Range(13, 13) = %v", got)
+	}
+	if got := collect(r, 0, 11); len(got) != 0 {
+		t.Errorf("Range over evicted ticks = %v", got)
+	}
+}
+
+func TestRingPushCopies(t *testing.T) {
+	r := NewRing(2)
+	buf := frameBytes(5)
+	if err := r.Push(buf); err != nil {
+		t.Fatal(err)
+	}
+	clear(buf)
+
+	got, ok := r.At(5)
+	if !ok || tickOf(got) != 5 {
+		t.Fatal("stored frame changed when the caller reused its buffer")
+	}
+}
+
+func TestRingIndexesByTick(t *testing.T) {
+	r := NewRing(4)
+	for _, tick := range []uint64{30, 10, 20} {
+		r.Push(frameBytes(tick))
+	}
+	if got := collect(r, 0, 100); !slices.Equal(got, []uint64{10, 20, 30}) {
+		t.Errorf("Range = %v, want ascending ticks", got)
+	}
+
+	// A duplicate tick replaces the earlier frame and survives the earlier
+	// slot's eviction.
+	r.Push(frameBytes(30))
+	r.Push(frameBytes(40))
+	if _, ok := r.At(30); !ok {
+		t.Error("replacement frame for tick 30 was evicted with the original")
+	}
+}
+
+func TestRingRejectsInvalidFrame(t *testing.T) {
+	r := NewRing(2)
+	if err := r.Push([]byte{1, 2}); err == nil {
+		t.Fatal("Push accepted a truncated frame")
+	}
+	if r.Len() != 0 {
+		t.Fatalf("Len = %d after rejected push", r.Len())
+	}
+}
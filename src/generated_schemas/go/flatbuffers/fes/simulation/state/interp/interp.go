@@ -0,0 +1,68 @@
+// Package interp smooths rendering between simulation ticks. The viewer draws
+// faster than the simulation steps, so it blends each entity's position from
+// one frame to the next. Results are plain Go values for the renderer, not
+// FlatBuffers.
+package interp
+
+import (
+	"cmp"
+	"slices"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// InterpolatedEntity is an entity's render state between two frames.
+type InterpolatedEntity struct {
+	ID       uint32
+	Position vecmath.Vec2f
+	// Alpha is the opacity to draw with. It is 1 for entities in both frames,
+	// fades in from 0 for entities that only appear in curr, and fades out to
+	// 0 for entities that only appear in prev.
+	Alpha float32
+}
+
+// InterpolateFrame blends prev towards curr at t, matching entities by ID.
+//
+// t is clamped to [0, 1], so t < 0 renders prev and t > 1 renders curr;
+// the renderer never extrapolates past the newest frame. Entities present in
+// both frames are lerped; entities present in only one stay at their position
+// there and fade via Alpha instead. The result is sorted by ID.
+//
+// A nil prev or curr is treated as an empty frame, so the first frame of a
+// stream fades in and the entities of a stream that ends fade out.
+func InterpolateFrame(prev, curr *state.Frame, t float32) []InterpolatedEntity {
+	t = min(max(t, 0), 1)
+
+	from := frame.Positions(prev)
+	if curr == nil {
+		out := make([]InterpolatedEntity, 0, len(from))
+		for id, p := range from {
+			out = append(out, InterpolatedEntity{ID: id, Position: p, Alpha: 1 - t})
+		}
+		return sortByID(out)
+	}
+	out := make([]InterpolatedEntity, 0, max(len(from), curr.EntitiesLength()))
+
+	for e := range frame.Entities(curr) {
+		id, to := e.ID(), e.Position()
+		if p, ok := from[id]; ok {
+			out = append(out, InterpolatedEntity{ID: id, Position: vecmath.Lerp(p, to, t), Alpha: 1})
+			delete(from, id)
+		} else {
+			out = append(out, InterpolatedEntity{ID: id, Position: to, Alpha: t})
+		}
+	}
+	for id, p := range from {
+		out = append(out, InterpolatedEntity{ID: id, Position: p, Alpha: 1 - t})
+	}
+	return sortByID(out)
+}
+
+func sortByID(out []InterpolatedEntity) []InterpolatedEntity {
+	slices.SortFunc(out, func(a, b InterpolatedEntity) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return out
+}
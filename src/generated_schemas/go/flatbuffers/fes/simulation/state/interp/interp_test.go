@@ -0,0 +1,75 @@
+package interp
+
+import (
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func buildFrame(tick uint64, entities ...frame.EntityArgs) *state.Frame {
+	fb := frame.FrameBuilder{Tick: tick, Entities: entities}
+	return state.GetRootAsFrame(fb.Finish(flatbuffers.NewBuilder(0)), 0)
+}
+
+func fish(id uint32, x, y float32) frame.EntityArgs {
+	return frame.Fish(frame.FishStateArgs{ID: id, Position: vecmath.Vec2f{X: x, Y: y}})
+}
+
+func TestInterpolateFrame(t *testing.T) {
+	prev := buildFrame(1, fish(3, 0, 0), fish(1, 10, 10))
+	curr := buildFrame(2, fish(2, 5, 5), fish(3, 4, -8))
+
+	got := InterpolateFrame(prev, curr, 0.25)
+	want := []InterpolatedEntity{
+		{ID: 1, Position: vecmath.Vec2f{X: 10, Y: 10}, Alpha: 0.75},
+		{ID: 2, Position: vecmath.Vec2f{X: 5, Y: 5}, Alpha: 0.25},
+		{ID: 3, Position: vecmath.Vec2f{X: 1, Y: -2}, Alpha: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d entities, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entity %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestInterpolateFrameNilFrames(t *testing.T) {
+	f := buildFrame(1, fish(2, 5, 5), fish(1, 10, 10))
+
+	in := InterpolateFrame(nil, f, 0.25)
+	out := InterpolateFrame(f, nil, 0.25)
+	for i, want := range []InterpolatedEntity{
+		{ID: 1, Position: vecmath.Vec2f{X: 10, Y: 10}},
+		{ID: 2, Position: vecmath.Vec2f{X: 5, Y: 5}},
+	} {
+		if want.Alpha = 0.25; len(in) != 2 || in[i] != want {
+			t.Errorf("nil prev: got %+v, want entity %d = %+v", in, i, want)
+		}
+		if want.Alpha = 0.75; len(out) != 2 || out[i] != want {
+			t.Errorf("nil curr: got %+v, want entity %d = %+v", out, i, want)
+		}
+	}
+	if got := InterpolateFrame(nil, nil, 0.5); len(got) != 0 {
+		t.Errorf("both nil: got %+v, want none", got)
+	}
+}
+
+func TestInterpolateFrameClampsT(t *testing.T) {
+	prev := buildFrame(1, fish(1, 0, 0), fish(2, 1, 1))
+	curr := buildFrame(2, fish(1, 10, 0))
+
+	before := InterpolateFrame(prev, curr, -3)
+	if before[0].Position != (vecmath.Vec2f{}) || before[1].Alpha != 1 {
+		t.Errorf("t < 0 must render prev: %+v", before)
+	}
+	after := InterpolateFrame(prev, curr, 7)
+	if after[0].Position != (vecmath.Vec2f{X: 10}) || after[1].Alpha != 0 {
+		t.Errorf("t > 1 must render curr: %+v", after)
+	}
+}
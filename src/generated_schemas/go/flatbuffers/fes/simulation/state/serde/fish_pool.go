@@ -0,0 +1,39 @@
+package serde
+
+import (
+	"sync"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+)
+
+// FishStatePool recycles the FishStateArgs structs that the tick loop fills
+// in for every fish before handing them to the frame builder. The zero value
+// is ready to use and a FishStatePool is safe for concurrent use by worker
+// goroutines.
+//
+// Like any sync.Pool, it holds idle structs only until the next garbage
+// collections, so its size is bounded by recent demand rather than growing
+// without limit.
+type FishStatePool struct {
+	pool sync.Pool
+}
+
+// Get returns a zeroed FishStateArgs.
+func (p *FishStatePool) Get() *frame.FishStateArgs {
+	if a, ok := p.pool.Get().(*frame.FishStateArgs); ok {
+		return a
+	}
+	return new(frame.FishStateArgs)
+}
+
+// Put zeroes a and returns it to the pool. Clearing happens here rather than
+// in Get so that an idle struct never holds the last user's species or
+// energy, which would otherwise be serialized silently by a caller that
+// forgot to set them. a must not be used again by the caller.
+func (p *FishStatePool) Put(a *frame.FishStateArgs) {
+	if a == nil {
+		return
+	}
+	*a = frame.FishStateArgs{}
+	p.pool.Put(a)
+}
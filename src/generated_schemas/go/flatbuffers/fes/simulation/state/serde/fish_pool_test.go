@@ -0,0 +1,90 @@
+package serde
+
+import (
+	"sync"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestFishStatePoolPutZeroes(t *testing.T) {
+	var p FishStatePool
+	a := p.Get()
+	*a = frame.FishStateArgs{
+		ID:       9,
+		Position: vecmath.Vec2f{X: 1, Y: 2},
+		Velocity: vecmath.Vec2f{X: 3},
+		Energy:   42,
+		AgeTicks: 7,
+		Species:  state.SpeciesPike,
+	}
+	p.Put(a)
+	if *a != (frame.FishStateArgs{}) {
+		t.Fatalf("Put left %+v in the struct", *a)
+	}
+	if got := p.Get(); *got != (frame.FishStateArgs{}) {
+		t.Fatalf("Get returned %+v, want zero", *got)
+	}
+	p.Put(nil)
+}
+
+// TestFishStatePoolConcurrent is meant for go test -race.
+func TestFishStatePoolConcurrent(t *testing.T) {
+	var p FishStatePool
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				a := p.Get()
+				if *a != (frame.FishStateArgs{}) {
+					t.Errorf("worker %d got stale %+v", w, *a)
+					return
+				}
+				a.ID = uint32(i)
+				a.Energy = float32(w)
+				a.Species = state.SpeciesCatfish
+				p.Put(a)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+var sinkArgs []*frame.FishStateArgs
+
+// BenchmarkTickFishArgs fills one FishStateArgs per fish for a 1000-fish tick.
+func BenchmarkTickFishArgs(b *testing.B) {
+	const fish = 1000
+	tick := func(get func() *frame.FishStateArgs, put func(*frame.FishStateArgs)) {
+		args := sinkArgs[:0]
+		for i := range fish {
+			a := get()
+			a.ID = uint32(i)
+			a.Energy = 1
+			args = append(args, a)
+		}
+		for _, a := range args {
+			put(a)
+		}
+		sinkArgs = args
+	}
+	sinkArgs = make([]*frame.FishStateArgs, 0, fish)
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			tick(func() *frame.FishStateArgs { return new(frame.FishStateArgs) }, func(*frame.FishStateArgs) {})
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		var p FishStatePool
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			tick(p.Get, p.Put)
+		}
+	})
+}
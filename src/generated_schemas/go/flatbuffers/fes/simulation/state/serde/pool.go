@@ -0,0 +1,39 @@
+// Package serde holds serialization plumbing shared by frame producers.
+package serde
+
+import (
+	"sync"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// BuilderPool recycles FlatBuffers builders between frames so that their
+// backing buffers are reused instead of reallocated and collected every tick.
+// The zero value is ready to use and a BuilderPool is safe for concurrent use.
+//
+// Bytes returned by a builder's FinishedBytes alias its internal buffer. Once
+// a builder goes back to the pool those bytes will be overwritten by the next
+// frame, so copy them, or finish every use of them, before calling Put.
+type BuilderPool struct {
+	pool sync.Pool
+}
+
+// Get returns a builder with no data in it. A recycled builder is Reset so
+// that nothing from its previous frame leaks into the new one; otherwise a new
+// builder with initialSize bytes of capacity is created.
+func (p *BuilderPool) Get(initialSize int) *flatbuffers.Builder {
+	if b, ok := p.pool.Get().(*flatbuffers.Builder); ok {
+		b.Reset()
+		return b
+	}
+	return flatbuffers.NewBuilder(initialSize)
+}
+
+// Put returns b to the pool. b must not be used again by the caller, and
+// neither may any slice obtained from its FinishedBytes.
+func (p *BuilderPool) Put(b *flatbuffers.Builder) {
+	if b == nil {
+		return
+	}
+	p.pool.Put(b)
+}
@@ -0,0 +1,70 @@
+package serde
+
+import (
+	"bytes"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func fishFrame(tick uint64, n int) *frame.FrameBuilder {
+	fb := &frame.FrameBuilder{Tick: tick, Entities: make([]frame.EntityArgs, n)}
+	for i := range fb.Entities {
+		fb.Entities[i] = frame.Fish(frame.FishStateArgs{
+			ID:       uint32(i),
+			Position: vecmath.Vec2f{X: float32(i), Y: float32(tick)},
+		})
+	}
+	return fb
+}
+
+func TestBuilderPoolResetsRecycledBuilders(t *testing.T) {
+	var p BuilderPool
+
+	b := p.Get(0)
+	first := bytes.Clone(fishFrame(1, 100).Finish(b))
+	p.Put(b)
+
+	b = p.Get(0)
+	small := fishFrame(2, 1).Finish(b)
+	f := state.GetRootAsFrame(small, 0)
+	if f.Tick() != 2 || f.EntitiesLength() != 1 {
+		t.Fatalf("recycled builder produced tick %d with %d entities", f.Tick(), f.EntitiesLength())
+	}
+
+	fresh := fishFrame(2, 1).Finish(flatbuffers.NewBuilder(0))
+	if !bytes.Equal(small, fresh) {
+		t.Fatal("recycled builder output differs from a fresh builder")
+	}
+	if bytes.Equal(first, small) {
+		t.Fatal("frames should differ")
+	}
+
+	p.Put(nil)
+}
+
+func BenchmarkSerialize10kFish(b *testing.B) {
+	frames := []*frame.FrameBuilder{fishFrame(1, 10000), fishFrame(2, 10000)}
+
+	b.Run("fresh", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			builder := flatbuffers.NewBuilder(1024)
+			frames[i%2].Finish(builder)
+		}
+	})
+
+	b.Run("pooled", func(b *testing.B) {
+		var p BuilderPool
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			builder := p.Get(1024)
+			frames[i%2].Finish(builder)
+			p.Put(builder)
+		}
+	})
+}
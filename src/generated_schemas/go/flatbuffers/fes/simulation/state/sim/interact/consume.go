@@ -0,0 +1,36 @@
+// Package interact holds the rules for how entities affect one another. Each
+// rule is a pure function of plain Go values, so it can be tested without
+// running the simulation loop or building frames.
+package interact
+
+import (
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+const (
+	// EatRadius is how close, in world units, a fish must be to a food item
+	// to eat it.
+	EatRadius float32 = 1
+	// MaxEnergy is the most energy a fish can hold. Eating never takes a
+	// fish past it.
+	MaxEnergy float32 = 100
+)
+
+// Consume applies the eating rule to fish and food: if the fish is strictly
+// within EatRadius of the food, including exactly on top of it, it gains the
+// food's nutrition and the food is consumed.
+//
+// The gain is capped so the result never exceeds MaxEnergy. A fish that is
+// already at or above MaxEnergy still consumes the food but keeps its energy
+// unchanged. When the food is out of reach, newEnergy is fish.Energy.
+func Consume(fish frame.FishStateArgs, food frame.FoodStateArgs) (newEnergy float32, consumed bool) {
+	if vecmath.Distance(fish.Position, food.Position) >= EatRadius {
+		return fish.Energy, false
+	}
+	newEnergy = fish.Energy + food.Nutrition
+	if newEnergy > MaxEnergy {
+		newEnergy = max(MaxEnergy, fish.Energy)
+	}
+	return newEnergy, true
+}
@@ -0,0 +1,38 @@
+package interact
+
+import (
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestConsume(t *testing.T) {
+	at := func(x, y float32) vecmath.Vec2f { return vecmath.Vec2f{X: x, Y: y} }
+	tests := []struct {
+		name         string
+		fishPos      vecmath.Vec2f
+		energy       float32
+		foodPos      vecmath.Vec2f
+		nutrition    float32
+		wantEnergy   float32
+		wantConsumed bool
+	}{
+		{"exact overlap", at(3, 4), 10, at(3, 4), 5, 15, true},
+		{"inside radius", at(0, 0), 10, at(0.6, 0), 5, 15, true},
+		{"on radius", at(0, 0), 10, at(EatRadius, 0), 5, 10, false},
+		{"out of reach", at(0, 0), 10, at(3, 4), 5, 10, false},
+		{"capped at max", at(0, 0), MaxEnergy - 1, at(0, 0), 5, MaxEnergy, true},
+		{"already over max", at(0, 0), MaxEnergy + 3, at(0, 0), 5, MaxEnergy + 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fish := frame.FishStateArgs{ID: 1, Position: tt.fishPos, Energy: tt.energy}
+			food := frame.FoodStateArgs{ID: 2, Position: tt.foodPos, Nutrition: tt.nutrition}
+			energy, consumed := Consume(fish, food)
+			if energy != tt.wantEnergy || consumed != tt.wantConsumed {
+				t.Errorf("Consume = %v, %v, want %v, %v", energy, consumed, tt.wantEnergy, tt.wantConsumed)
+			}
+		})
+	}
+}
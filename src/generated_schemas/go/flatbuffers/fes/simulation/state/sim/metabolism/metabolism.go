@@ -0,0 +1,43 @@
+// Package metabolism holds the rule for how fish burn energy over time and
+// die when it runs out. Like the rules in package interact, it is a pure
+// function of plain Go values.
+package metabolism
+
+import (
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/sim/species"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// StepMetabolism burns dt seconds' worth of energy from fish and reports
+// whether it is still alive afterwards.
+//
+// A fish at rest burns cfg.MetabolismRate per second, and swimming costs
+// extra in proportion to speed: the rate is multiplied by
+// 1 + |velocity| / cfg.MaxSpeed, so a fish at full speed burns twice its
+// resting rate. A species with a MaxSpeed of 0 always burns the resting rate.
+//
+// Energy is clamped at zero, never going negative, and the fish is dead once
+// it reaches zero. A fish that starts at or below zero energy, or at NaN, is
+// returned dead with energy 0 and nothing else applied. Only Energy is
+// changed; dt <= 0 burns nothing.
+func StepMetabolism(fish frame.FishStateArgs, cfg species.SpeciesConfig, dt float32) (updated frame.FishStateArgs, alive bool) {
+	if !(fish.Energy > 0) {
+		fish.Energy = 0
+		return fish, false
+	}
+	if dt <= 0 {
+		return fish, true
+	}
+
+	rate := cfg.MetabolismRate
+	if cfg.MaxSpeed > 0 {
+		rate *= 1 + vecmath.Length(fish.Velocity)/cfg.MaxSpeed
+	}
+	fish.Energy -= rate * dt
+	if !(fish.Energy > 0) {
+		fish.Energy = 0
+		return fish, false
+	}
+	return fish, true
+}
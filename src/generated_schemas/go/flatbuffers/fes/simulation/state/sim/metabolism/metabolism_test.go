@@ -0,0 +1,65 @@
+package metabolism
+
+import (
+	"math"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/frame"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/sim/species"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestStepMetabolism(t *testing.T) {
+	cfg := species.SpeciesConfig{MaxSpeed: 4, MetabolismRate: 2}
+	tests := []struct {
+		name       string
+		energy     float32
+		velocity   vecmath.Vec2f
+		cfg        species.SpeciesConfig
+		dt         float32
+		wantEnergy float32
+		wantAlive  bool
+	}{
+		{"at rest", 10, vecmath.Vec2f{}, cfg, 0.5, 9, true},
+		{"half speed", 10, vecmath.Vec2f{X: 2}, cfg, 0.5, 8.5, true},
+		{"full speed", 10, vecmath.Vec2f{X: 0, Y: -4}, cfg, 0.5, 8, true},
+		{"diagonal full speed", 10, vecmath.Vec2f{X: 2.4, Y: 3.2}, cfg, 1, 6, true},
+		{"no max speed", 10, vecmath.Vec2f{X: 9}, species.SpeciesConfig{MetabolismRate: 2}, 1, 8, true},
+		{"exactly to zero", 1, vecmath.Vec2f{}, cfg, 0.5, 0, false},
+		{"just above zero", 1.0625, vecmath.Vec2f{}, cfg, 0.5, 0.0625, true},
+		{"past zero clamps", 1, vecmath.Vec2f{X: 4}, cfg, 10, 0, false},
+		{"already zero", 0, vecmath.Vec2f{}, species.SpeciesConfig{}, 1, 0, false},
+		{"already negative", -3, vecmath.Vec2f{}, cfg, 0, 0, false},
+		{"NaN energy", float32(math.NaN()), vecmath.Vec2f{}, cfg, 1, 0, false},
+		{"zero dt", 5, vecmath.Vec2f{X: 4}, cfg, 0, 5, true},
+		{"no metabolism", 5, vecmath.Vec2f{X: 4}, species.SpeciesConfig{MaxSpeed: 4}, 100, 5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fish := frame.FishStateArgs{ID: 7, Position: vecmath.Vec2f{X: 1}, Velocity: tt.velocity, Energy: tt.energy, AgeTicks: 3}
+			got, alive := StepMetabolism(fish, tt.cfg, tt.dt)
+			if got.Energy != tt.wantEnergy || alive != tt.wantAlive {
+				t.Errorf("StepMetabolism = energy %v, alive %v; want %v, %v", got.Energy, alive, tt.wantEnergy, tt.wantAlive)
+			}
+			fish.Energy = got.Energy
+			if got != fish {
+				t.Errorf("StepMetabolism changed more than Energy: %+v", got)
+			}
+		})
+	}
+}
+
+func TestStepMetabolismDeadAtZeroAfterRepeatedSteps(t *testing.T) {
+	fish := frame.FishStateArgs{Energy: 1}
+	cfg := species.SpeciesConfig{MetabolismRate: 0.25}
+	steps := 0
+	for alive := true; alive; steps++ {
+		fish, alive = StepMetabolism(fish, cfg, 1)
+		if fish.Energy < 0 {
+			t.Fatalf("energy went negative: %v", fish.Energy)
+		}
+	}
+	if steps != 4 || fish.Energy != 0 {
+		t.Fatalf("died after %d steps with energy %v, want 4 steps and 0", steps, fish.Energy)
+	}
+}
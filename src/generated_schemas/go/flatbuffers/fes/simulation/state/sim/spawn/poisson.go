@@ -0,0 +1,132 @@
+// Package spawn places the initial population of a simulation.
+package spawn
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// candidatesPerPoint is how many candidates are tried around each point
+// before it is retired, the usual choice for Bridson's algorithm.
+const candidatesPerPoint = 30
+
+// PoissonDiskSample returns up to maxPoints points inside bounds, no two
+// closer than minDist, using Bridson's algorithm. The result is the same for
+// the same parameters and the same rng state.
+//
+// Sampling stops as soon as maxPoints points are placed. Otherwise each point
+// is retired after candidatesPerPoint (30) failed candidates around it, and
+// sampling ends when every point is retired, so at most 30 candidates are
+// drawn per returned point however dense the configuration; a crowded box
+// returns fewer than maxPoints points rather than looping. When minDist
+// exceeds the box, that means a single point.
+//
+// An empty bounds or a non-positive maxPoints returns nil. A minDist <= 0
+// places no constraint, and maxPoints uniform points are returned.
+func PoissonDiskSample(bounds vecmath.AABB, minDist float32, maxPoints int, rng *rand.Rand) []vecmath.Vec2f {
+	if bounds.Empty() || maxPoints <= 0 {
+		return nil
+	}
+	if !(minDist > 0) {
+		out := make([]vecmath.Vec2f, 0, maxPoints)
+		for len(out) < maxPoints {
+			if p := uniform(bounds, rng); bounds.Contains(p) {
+				out = append(out, p)
+			}
+		}
+		return out
+	}
+
+	s := sampler{
+		bounds:   bounds,
+		r2:       float64(minDist) * float64(minDist),
+		cellSize: float64(minDist) / math.Sqrt2,
+		cells:    make(map[[2]int64]int),
+	}
+	first := uniform(bounds, rng)
+	for !bounds.Contains(first) {
+		first = uniform(bounds, rng)
+	}
+	s.add(first)
+
+	active := []int{0}
+	for len(active) > 0 && len(s.points) < maxPoints {
+		k := rng.IntN(len(active))
+		p := s.points[active[k]]
+		placed := false
+		for range candidatesPerPoint {
+			angle := rng.Float64() * 2 * math.Pi
+			dist := float64(minDist) * (1 + rng.Float64())
+			c := vecmath.Vec2f{
+				X: float32(float64(p.X) + dist*math.Cos(angle)),
+				Y: float32(float64(p.Y) + dist*math.Sin(angle)),
+			}
+			if s.fits(c) {
+				active = append(active, s.add(c))
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			active[k] = active[len(active)-1]
+			active = active[:len(active)-1]
+		}
+	}
+	return s.points
+}
+
+// sampler is a background grid over the accepted points. Cells are small
+// enough to hold at most one point, keyed sparsely so huge bounds cost
+// nothing until points land in them.
+type sampler struct {
+	bounds   vecmath.AABB
+	r2       float64
+	cellSize float64
+	cells    map[[2]int64]int
+	points   []vecmath.Vec2f
+}
+
+func (s *sampler) cellOf(p vecmath.Vec2f) [2]int64 {
+	return [2]int64{
+		int64(math.Floor((float64(p.X) - float64(s.bounds.Min.X)) / s.cellSize)),
+		int64(math.Floor((float64(p.Y) - float64(s.bounds.Min.Y)) / s.cellSize)),
+	}
+}
+
+func (s *sampler) add(p vecmath.Vec2f) int {
+	s.points = append(s.points, p)
+	s.cells[s.cellOf(p)] = len(s.points) - 1
+	return len(s.points) - 1
+}
+
+// fits reports whether p is inside the bounds and at least minDist from
+// every accepted point. Such points can only lie within two cells of p.
+func (s *sampler) fits(p vecmath.Vec2f) bool {
+	if !s.bounds.Contains(p) {
+		return false
+	}
+	c := s.cellOf(p)
+	for dx := int64(-2); dx <= 2; dx++ {
+		for dy := int64(-2); dy <= 2; dy++ {
+			i, ok := s.cells[[2]int64{c[0] + dx, c[1] + dy}]
+			if !ok {
+				continue
+			}
+			q := s.points[i]
+			ex, ey := float64(p.X)-float64(q.X), float64(p.Y)-float64(q.Y)
+			if ex*ex+ey*ey < s.r2 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func uniform(b vecmath.AABB, rng *rand.Rand) vecmath.Vec2f {
+	return vecmath.Vec2f{
+		X: b.Min.X + (b.Max.X-b.Min.X)*rng.Float32(),
+		Y: b.Min.Y + (b.Max.Y-b.Min.Y)*rng.Float32(),
+	}
+}
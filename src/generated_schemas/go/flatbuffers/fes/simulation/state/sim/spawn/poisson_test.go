@@ -0,0 +1,72 @@
+package spawn
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+var box = vecmath.AABB{Min: vecmath.Vec2f{X: -50, Y: 0}, Max: vecmath.Vec2f{X: 150, Y: 100}}
+
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, 0))
+}
+
+func assertSpacing(t *testing.T, points []vecmath.Vec2f, minDist float32) {
+	t.Helper()
+	for i, p := range points {
+		if !box.Contains(p) {
+			t.Errorf("point %v outside %v", p, box)
+		}
+		for _, q := range points[:i] {
+			if d := vecmath.Distance(p, q); d < minDist {
+				t.Fatalf("points %v and %v are %v apart, want >= %v", p, q, d, minDist)
+			}
+		}
+	}
+}
+
+func TestPoissonDiskSampleSpacing(t *testing.T) {
+	for _, minDist := range []float32{2, 7.5, 30} {
+		points := PoissonDiskSample(box, minDist, 10000, newRand(1))
+		if len(points) < 2 {
+			t.Fatalf("minDist %v: only %d points", minDist, len(points))
+		}
+		assertSpacing(t, points, minDist)
+	}
+}
+
+func TestPoissonDiskSampleDeterministic(t *testing.T) {
+	a := PoissonDiskSample(box, 5, 500, newRand(7))
+	b := PoissonDiskSample(box, 5, 500, newRand(7))
+	if !slices.Equal(a, b) {
+		t.Fatal("same seed produced different points")
+	}
+	if c := PoissonDiskSample(box, 5, 500, newRand(8)); slices.Equal(a, c) {
+		t.Fatal("different seeds produced the same points")
+	}
+}
+
+func TestPoissonDiskSampleDegenerate(t *testing.T) {
+	if got := PoissonDiskSample(box, 1000, 50, newRand(1)); len(got) != 1 {
+		t.Errorf("minDist larger than the box: %d points, want 1", len(got))
+	}
+
+	got := PoissonDiskSample(box, 1, 40, newRand(2))
+	if len(got) != 40 {
+		t.Errorf("maxPoints 40 in a roomy box: %d points", len(got))
+	}
+	assertSpacing(t, got, 1)
+
+	if got := PoissonDiskSample(box, 0, 25, newRand(3)); len(got) != 25 {
+		t.Errorf("minDist 0: %d points, want 25", len(got))
+	}
+	if got := PoissonDiskSample(vecmath.AABB{}, 1, 10, newRand(4)); got != nil {
+		t.Errorf("empty bounds: %v, want nil", got)
+	}
+	if got := PoissonDiskSample(box, 1, 0, newRand(5)); got != nil {
+		t.Errorf("maxPoints 0: %v, want nil", got)
+	}
+}
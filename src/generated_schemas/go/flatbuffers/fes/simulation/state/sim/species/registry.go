@@ -0,0 +1,129 @@
+// Package species holds the tunable behaviour parameters of each species and
+// checks at startup that every species in the schema has them.
+package species
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// SpeciesConfig is the behaviour parameters of one species.
+type SpeciesConfig struct {
+	// MaxSpeed is the fastest a fish can swim, in world units per second.
+	MaxSpeed float32 `json:"max_speed"`
+	// VisionRadius is how far a fish can see food and other fish, in world
+	// units.
+	VisionRadius float32 `json:"vision_radius"`
+	// MetabolismRate is the energy a fish burns per second.
+	MetabolismRate float32 `json:"metabolism_rate"`
+}
+
+func (c SpeciesConfig) validate() error {
+	for _, f := range []struct {
+		name string
+		v    float32
+	}{
+		{"max_speed", c.MaxSpeed},
+		{"vision_radius", c.VisionRadius},
+		{"metabolism_rate", c.MetabolismRate},
+	} {
+		if !(f.v >= 0) || math.IsInf(float64(f.v), 1) {
+			return fmt.Errorf("%s = %v, want a finite non-negative value", f.name, f.v)
+		}
+	}
+	return nil
+}
+
+// Registry maps every species to its configuration. It is built once at
+// startup and is read-only afterwards, so it is safe for concurrent use.
+type Registry struct {
+	configs map[state.Species]SpeciesConfig
+}
+
+// NewRegistry returns a registry of configs. It fails if any species defined
+// in the schema (every key of state.EnumNamesSpecies, including Unknown) has
+// no entry, if configs has an entry for a value the schema does not define,
+// or if any parameter is negative or not finite. configs is copied.
+func NewRegistry(configs map[state.Species]SpeciesConfig) (*Registry, error) {
+	return newRegistry(configs, state.EnumNamesSpecies)
+}
+
+func newRegistry(configs map[state.Species]SpeciesConfig, names map[state.Species]string) (*Registry, error) {
+	var missing []string
+	for s, name := range names {
+		if _, ok := configs[s]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return nil, fmt.Errorf("species: no config for %v", missing)
+	}
+
+	r := &Registry{configs: make(map[state.Species]SpeciesConfig, len(configs))}
+	for s, c := range configs {
+		if _, ok := names[s]; !ok {
+			return nil, fmt.Errorf("species: config for undefined %v", s)
+		}
+		if err := c.validate(); err != nil {
+			return nil, fmt.Errorf("species: %v: %w", s, err)
+		}
+		r.configs[s] = c
+	}
+	return r, nil
+}
+
+// DecodeRegistry reads a JSON object keyed by species name, matched with
+// state.ParseSpecies, and builds a registry from it as NewRegistry does:
+//
+//	{"Guppy": {"max_speed": 4, "vision_radius": 10, "metabolism_rate": 0.1}, ...}
+//
+// Unknown species names and unknown parameter fields are errors, so a typo
+// fails loudly instead of leaving a species on zero values.
+func DecodeRegistry(r io.Reader) (*Registry, error) {
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("species: decoding config: %w", err)
+	}
+
+	configs := make(map[state.Species]SpeciesConfig, len(raw))
+	for name, msg := range raw {
+		s, ok := state.ParseSpecies(name)
+		if !ok {
+			return nil, fmt.Errorf("species: config for unknown species %q", name)
+		}
+		if _, dup := configs[s]; dup {
+			return nil, fmt.Errorf("species: %v configured more than once", s)
+		}
+		var c SpeciesConfig
+		dec := json.NewDecoder(bytes.NewReader(msg))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&c); err != nil {
+			return nil, fmt.Errorf("species: decoding %v: %w", s, err)
+		}
+		configs[s] = c
+	}
+	return NewRegistry(configs)
+}
+
+// Get returns the configuration of s, and whether it has one. Every species
+// defined in the schema does, so false means s came from a newer writer.
+func (r *Registry) Get(s state.Species) (SpeciesConfig, bool) {
+	c, ok := r.configs[s]
+	return c, ok
+}
+
+// MustGet returns the configuration of s. It panics if s has none.
+func (r *Registry) MustGet(s state.Species) SpeciesConfig {
+	c, ok := r.configs[s]
+	if !ok {
+		panic(fmt.Sprintf("species: no config registered for %v", s))
+	}
+	return c
+}
@@ -0,0 +1,118 @@
+package species
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"strings"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+func fullConfig() map[state.Species]SpeciesConfig {
+	configs := make(map[state.Species]SpeciesConfig, len(state.EnumNamesSpecies))
+	for s := range state.EnumNamesSpecies {
+		configs[s] = SpeciesConfig{MaxSpeed: float32(s) + 1, VisionRadius: 10, MetabolismRate: 0.1}
+	}
+	return configs
+}
+
+func TestRegistryGet(t *testing.T) {
+	configs := fullConfig()
+	r, err := NewRegistry(configs)
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	for s, want := range configs {
+		if got, ok := r.Get(s); !ok || got != want {
+			t.Errorf("Get(%v) = %+v, %v, want %+v", s, got, ok, want)
+		}
+		if got := r.MustGet(s); got != want {
+			t.Errorf("MustGet(%v) = %+v, want %+v", s, got, want)
+		}
+	}
+
+	// The registry owns its copy.
+	configs[state.SpeciesPike] = SpeciesConfig{}
+	if r.MustGet(state.SpeciesPike).MaxSpeed == 0 {
+		t.Error("registry aliases the caller's map")
+	}
+}
+
+func TestRegistryMustGetPanicsOnUnregistered(t *testing.T) {
+	r, err := NewRegistry(fullConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	future := state.Species(len(state.EnumNamesSpecies) + 10)
+	if _, ok := r.Get(future); ok {
+		t.Fatalf("Get(%v) found a config", future)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("MustGet did not panic")
+		}
+	}()
+	r.MustGet(future)
+}
+
+func TestRegistryDetectsMissingSpecies(t *testing.T) {
+	configs := fullConfig()
+	delete(configs, state.SpeciesCatfish)
+	if _, err := NewRegistry(configs); err == nil || !strings.Contains(err.Error(), "Catfish") {
+		t.Fatalf("NewRegistry without Catfish = %v, want an error naming it", err)
+	}
+
+	// A species added to the schema without a matching config entry.
+	names := maps.Clone(state.EnumNamesSpecies)
+	names[42] = "Piranha"
+	if _, err := newRegistry(fullConfig(), names); err == nil || !strings.Contains(err.Error(), "Piranha") {
+		t.Fatalf("new enum value without a config = %v, want an error naming it", err)
+	}
+}
+
+func TestRegistryRejectsBadEntries(t *testing.T) {
+	extra := fullConfig()
+	extra[42] = SpeciesConfig{}
+	if _, err := NewRegistry(extra); err == nil {
+		t.Error("config for an undefined species accepted")
+	}
+
+	negative := fullConfig()
+	negative[state.SpeciesGuppy] = SpeciesConfig{MaxSpeed: -1}
+	if _, err := NewRegistry(negative); err == nil {
+		t.Error("negative max speed accepted")
+	}
+}
+
+func TestDecodeRegistry(t *testing.T) {
+	want := SpeciesConfig{MaxSpeed: 2.5, VisionRadius: 8, MetabolismRate: 0.05}
+	byName := make(map[string]SpeciesConfig)
+	for _, name := range state.EnumNamesSpecies {
+		byName[strings.ToLower(name)] = want
+	}
+	in, err := json.Marshal(byName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := DecodeRegistry(bytes.NewReader(in))
+	if err != nil {
+		t.Fatalf("DecodeRegistry: %v", err)
+	}
+	if got := r.MustGet(state.SpeciesAngelfish); got != want {
+		t.Errorf("Angelfish = %+v, want %+v", got, want)
+	}
+
+	for name, in := range map[string]string{
+		"unknown species": `{"Shark": {}}`,
+		"unknown field":   `{"Guppy": {"max_sped": 1}}`,
+		"duplicate":       `{"Guppy": {}, "guppy": {}}`,
+		"missing species": `{"Guppy": {}}`,
+	} {
+		if _, err := DecodeRegistry(strings.NewReader(in)); err == nil {
+			t.Errorf("%s: DecodeRegistry succeeded", name)
+		}
+	}
+}
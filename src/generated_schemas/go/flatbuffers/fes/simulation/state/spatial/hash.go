@@ -0,0 +1,137 @@
+package spatial
+
+import (
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/grid"
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+type cell struct {
+	x, y int32
+}
+
+// SpatialHash buckets entities into square grid cells. It suits roughly
+// uniform densities better than a Quadtree and is simpler to parallelise:
+// the intended use is one Rebuild per tick followed by many read-only
+// queries, which may then run concurrently.
+//
+// Unlike a Quadtree it has no bounds; any finite position can be indexed.
+type SpatialHash struct {
+	cellSize float32
+	cells    map[cell][]entry
+}
+
+// NewSpatialHash returns an empty hash with the given cell size. For radius
+// queries, a cell size close to the typical query radius keeps each query to
+// a 3x3 block of cells.
+func NewSpatialHash(cellSize float32) *SpatialHash {
+	if cellSize <= 0 {
+		panic("spatial: cell size must be positive")
+	}
+	return &SpatialHash{cellSize: cellSize, cells: make(map[cell][]entry)}
+}
+
+// CellSize returns the side length of each cell.
+func (h *SpatialHash) CellSize() float32 {
+	return h.cellSize
+}
+
+// Rebuild replaces the contents of the hash with positions in a single pass.
+// Cell storage from the previous build is reused where possible, so a steady
+// population rebuilds without reallocating.
+func (h *SpatialHash) Rebuild(positions map[uint32]vecmath.Vec2f) {
+	for c, entries := range h.cells {
+		h.cells[c] = entries[:0]
+	}
+	for id, p := range positions {
+		c := h.cellOf(p)
+		h.cells[c] = append(h.cells[c], entry{id: id, pos: p})
+	}
+	for c, entries := range h.cells {
+		if len(entries) == 0 {
+			delete(h.cells, c)
+		}
+	}
+}
+
+// Neighbors returns the IDs of all entities within distance radius of center,
+// inclusive, in no particular order. It scans every cell overlapping the
+// query circle's bounding square (3x3 when radius <= cell size, wider for
+// larger radii) and filters candidates by their actual distance.
+func (h *SpatialHash) Neighbors(center vecmath.Vec2f, radius float32) []uint32 {
+	return h.QueryRadiusInto(center, radius, nil)
+}
+
+// QueryRadiusInto is Neighbors appending into dst[:0] rather than a new
+// slice, so a caller reusing one scratch buffer across queries allocates only
+// when a result outgrows it. The returned slice aliases dst whenever it fits,
+// and so is overwritten by the next query into the same buffer.
+func (h *SpatialHash) QueryRadiusInto(center vecmath.Vec2f, radius float32, dst []uint32) []uint32 {
+	out := dst[:0]
+	if radius < 0 {
+		return out
+	}
+	r2 := radius * radius
+	h.visitBlock(center, radius, func(entries []entry) {
+		for _, e := range entries {
+			if vecmath.LengthSq(vecmath.Sub(e.pos, center)) <= r2 {
+				out = append(out, e.id)
+			}
+		}
+	})
+	return out
+}
+
+// QueryRangeInto appends the IDs of all entities inside r to dst[:0], in no
+// particular order, and returns the result, which aliases dst as for
+// QueryRadiusInto.
+func (h *SpatialHash) QueryRangeInto(r vecmath.AABB, dst []uint32) []uint32 {
+	out := dst[:0]
+	if r.Empty() {
+		return out
+	}
+	h.visitCells(h.cellOf(r.Min), h.cellOf(r.Max), func(entries []entry) {
+		for _, e := range entries {
+			if r.Contains(e.pos) {
+				out = append(out, e.id)
+			}
+		}
+	})
+	return out
+}
+
+// visitBlock calls visit with the entries of every populated cell overlapping
+// the square of half-width radius around center.
+func (h *SpatialHash) visitBlock(center vecmath.Vec2f, radius float32, visit func([]entry)) {
+	lo := h.cellOf(vecmath.Vec2f{X: center.X - radius, Y: center.Y - radius})
+	hi := h.cellOf(vecmath.Vec2f{X: center.X + radius, Y: center.Y + radius})
+	h.visitCells(lo, hi, visit)
+}
+
+// visitCells calls visit with the entries of every populated cell from lo to
+// hi inclusive on both axes.
+func (h *SpatialHash) visitCells(lo, hi cell, visit func([]entry)) {
+	span := (int64(hi.x) - int64(lo.x) + 1) * (int64(hi.y) - int64(lo.y) + 1)
+	if span > int64(len(h.cells)) {
+		// The block is larger than the populated cells; walking those is
+		// cheaper and avoids looping over a huge empty range.
+		for c, entries := range h.cells {
+			if c.x >= lo.x && c.x <= hi.x && c.y >= lo.y && c.y <= hi.y {
+				visit(entries)
+			}
+		}
+		return
+	}
+	// Count in int64: an int32 counter would wrap past a hi of MaxInt32.
+	for x := int64(lo.x); x <= int64(hi.x); x++ {
+		for y := int64(lo.y); y <= int64(hi.y); y++ {
+			if entries := h.cells[cell{int32(x), int32(y)}]; len(entries) > 0 {
+				visit(entries)
+			}
+		}
+	}
+}
+
+func (h *SpatialHash) cellOf(p vecmath.Vec2f) cell {
+	x, y := grid.CellOf(p, h.cellSize)
+	return cell{x, y}
+}
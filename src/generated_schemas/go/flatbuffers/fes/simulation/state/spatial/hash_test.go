@@ -0,0 +1,107 @@
+package spatial
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestSpatialHashMatchesBruteForce(t *testing.T) {
+	positions := randomPositions(2000, 5)
+	// Negative coordinates exercise cells on both sides of the origin.
+	positions[5000] = vecmath.Vec2f{X: -0.5, Y: -0.5}
+	positions[5001] = vecmath.Vec2f{X: 0.5, Y: 0.5}
+
+	h := NewSpatialHash(20)
+	h.Rebuild(positions)
+
+	centers := []vecmath.Vec2f{{X: 500, Y: 500}, {X: 0, Y: 0}, {X: 999, Y: 999}, {X: 10, Y: 990}}
+	for _, c := range centers {
+		for _, r := range []float32{0, 5, 20, 55, 5000} {
+			got := sorted(h.Neighbors(c, r))
+			want := sorted(bruteRadius(positions, c, r))
+			if !slices.Equal(got, want) {
+				t.Errorf("Neighbors(%v, %v): got %d ids, want %d", c, r, len(got), len(want))
+			}
+		}
+	}
+}
+
+func TestSpatialHashRebuildClears(t *testing.T) {
+	h := NewSpatialHash(10)
+	h.Rebuild(map[uint32]vecmath.Vec2f{1: {X: 5, Y: 5}, 2: {X: 95, Y: 95}})
+	h.Rebuild(map[uint32]vecmath.Vec2f{3: {X: 6, Y: 6}})
+
+	if got := sorted(h.Neighbors(vecmath.Vec2f{X: 5, Y: 5}, 200)); !slices.Equal(got, []uint32{3}) {
+		t.Fatalf("Neighbors after rebuild = %v, want [3]", got)
+	}
+	if len(h.cells) != 1 {
+		t.Fatalf("%d cells retained, want 1", len(h.cells))
+	}
+}
+
+func TestSpatialHashEdgeCells(t *testing.T) {
+	// Positions this far out clamp to the first and last cells of the int32
+	// grid; the cell loop must stop there rather than wrap around.
+	h := NewSpatialHash(1)
+	h.Rebuild(map[uint32]vecmath.Vec2f{1: {X: 3e38, Y: 3e38}, 2: {X: -3e38, Y: -3e38}})
+
+	for id, p := range map[uint32]vecmath.Vec2f{1: {X: 3e38, Y: 3e38}, 2: {X: -3e38, Y: -3e38}} {
+		if got := h.Neighbors(p, 1); !slices.Equal(got, []uint32{id}) {
+			t.Errorf("Neighbors(%v, 1) = %v, want [%d]", p, got, id)
+		}
+	}
+}
+
+func TestSpatialHashQueryIntoReusesBuffer(t *testing.T) {
+	positions := randomPositions(3000, 12)
+	h := NewSpatialHash(25)
+	h.Rebuild(positions)
+	checkQueryIntoReusesBuffer(t, positions, h.QueryRangeInto, h.QueryRadiusInto)
+
+	box := vecmath.AABB{Min: vecmath.Vec2f{X: 100, Y: 200}, Max: vecmath.Vec2f{X: 180, Y: 260}}
+	if got := h.QueryRangeInto(vecmath.AABB{Min: box.Max, Max: box.Min}, nil); len(got) != 0 {
+		t.Errorf("QueryRangeInto over an inverted box = %v, want empty", got)
+	}
+}
+
+func TestSpatialHashQueryIntoDoesNotAllocate(t *testing.T) {
+	h := NewSpatialHash(25)
+	h.Rebuild(randomPositions(10000, 13))
+	checkQueryRadiusIntoDoesNotAllocate(t, h.QueryRadiusInto)
+}
+
+func BenchmarkRebuildAndQuery(b *testing.B) {
+	const r = 20
+	for _, n := range []int{1000, 10000, 50000} {
+		positions := randomPositions(n, 6)
+		centers := make([]vecmath.Vec2f, 0, 256)
+		for _, p := range randomPositions(256, 7) {
+			centers = append(centers, p)
+		}
+
+		b.Run(fmt.Sprintf("hash/n=%d", n), func(b *testing.B) {
+			h := NewSpatialHash(r)
+			for i := 0; i < b.N; i++ {
+				h.Rebuild(positions)
+				for _, c := range centers {
+					h.Neighbors(c, r)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("quadtree/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				q := NewQuadtree(worldBounds, DefaultCapacity)
+				for id, p := range positions {
+					q.Insert(id, p)
+				}
+				for _, c := range centers {
+					q.QueryRadius(c, r)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package spatial
+
+import "fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+
+// ComputeNeighborCounts returns, for every entity in positions, how many other
+// entities lie strictly within radius of it. It builds one SpatialHash with a
+// cell size of radius and runs a single block query per entity, comparing
+// squared distances so no square roots are taken.
+//
+// An entity never counts itself, but two entities at exactly the same
+// position count each other, since 0 < radius. A radius that is not positive
+// yields a count of 0 for every entity.
+func ComputeNeighborCounts(positions map[uint32]vecmath.Vec2f, radius float32) map[uint32]int {
+	counts := make(map[uint32]int, len(positions))
+	if !(radius > 0) {
+		for id := range positions {
+			counts[id] = 0
+		}
+		return counts
+	}
+
+	h := NewSpatialHash(radius)
+	h.Rebuild(positions)
+	r2 := radius * radius
+	for id, p := range positions {
+		n := 0
+		h.visitBlock(p, radius, func(entries []entry) {
+			for _, e := range entries {
+				if e.id != id && vecmath.LengthSq(vecmath.Sub(e.pos, p)) < r2 {
+					n++
+				}
+			}
+		})
+		counts[id] = n
+	}
+	return counts
+}
@@ -0,0 +1,74 @@
+package spatial
+
+import (
+	"fmt"
+	"maps"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func bruteNeighborCounts(positions map[uint32]vecmath.Vec2f, radius float32) map[uint32]int {
+	counts := make(map[uint32]int, len(positions))
+	for id, p := range positions {
+		n := 0
+		for other, q := range positions {
+			if other != id && vecmath.LengthSq(vecmath.Sub(p, q)) < radius*radius {
+				n++
+			}
+		}
+		counts[id] = n
+	}
+	return counts
+}
+
+func TestComputeNeighborCountsMatchesBruteForce(t *testing.T) {
+	positions := randomPositions(1500, 11)
+	positions[9000] = vecmath.Vec2f{X: -3, Y: -3}
+	for _, r := range []float32{1, 15, 80} {
+		got := ComputeNeighborCounts(positions, r)
+		if want := bruteNeighborCounts(positions, r); !maps.Equal(got, want) {
+			t.Errorf("radius %v: counts differ from brute force", r)
+		}
+	}
+}
+
+func TestComputeNeighborCountsEdgeCases(t *testing.T) {
+	positions := map[uint32]vecmath.Vec2f{
+		1: {X: 5, Y: 5},
+		2: {X: 5, Y: 5}, // exactly on top of 1
+		3: {X: 7, Y: 5}, // exactly radius away from 1 and 2
+		4: {X: 50, Y: 50},
+	}
+	got := ComputeNeighborCounts(positions, 2)
+	want := map[uint32]int{1: 1, 2: 1, 3: 0, 4: 0}
+	if !maps.Equal(got, want) {
+		t.Errorf("counts = %v, want %v", got, want)
+	}
+
+	for _, r := range []float32{0, -1} {
+		got := ComputeNeighborCounts(positions, r)
+		if len(got) != len(positions) || got[1] != 0 || got[2] != 0 {
+			t.Errorf("radius %v: counts = %v, want all zero", r, got)
+		}
+	}
+	if got := ComputeNeighborCounts(nil, 3); len(got) != 0 {
+		t.Errorf("no positions: counts = %v", got)
+	}
+}
+
+func BenchmarkComputeNeighborCounts(b *testing.B) {
+	const r = 10
+	positions := randomPositions(10000, 12)
+	b.Run(fmt.Sprintf("hash/n=%d", len(positions)), func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ComputeNeighborCounts(positions, r)
+		}
+	})
+	b.Run(fmt.Sprintf("brute/n=%d", len(positions)), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bruteNeighborCounts(positions, r)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package spatial
+
+import (
+	"math"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// QueryRadiusPredicted returns the IDs of the entities that will be within
+// radius of center, inclusive, after leadTime seconds, assuming each keeps
+// its current velocity: a candidate at p moving at v is tested at
+// p + v*leadTime. It is meant for lead-targeting, where a predator steers
+// towards where prey will be rather than where it is.
+//
+// index must have been rebuilt from positions, which supplies each
+// candidate's current position; velocities are in world units per second and
+// an entity missing from velocities is treated as stationary, behaving as in
+// a plain query. A leadTime of 0 is exactly index.Neighbors(center, radius).
+//
+// So that fast entities currently outside the search area are not missed, the
+// cell block scanned is widened by the largest speed in velocities times
+// |leadTime|. Finding that speed costs one pass over velocities per call.
+func QueryRadiusPredicted(index *SpatialHash, positions, velocities map[uint32]vecmath.Vec2f, center vecmath.Vec2f, radius, leadTime float32) []uint32 {
+	if leadTime == 0 || radius < 0 {
+		return index.Neighbors(center, radius)
+	}
+
+	var maxSpeedSq float32
+	for _, v := range velocities {
+		maxSpeedSq = max(maxSpeedSq, vecmath.LengthSq(v))
+	}
+	reach := radius + float32(math.Sqrt(float64(maxSpeedSq)))*float32(math.Abs(float64(leadTime)))
+
+	var out []uint32
+	r2 := radius * radius
+	index.visitBlock(center, reach, func(entries []entry) {
+		for _, e := range entries {
+			p, ok := positions[e.id]
+			if !ok {
+				p = e.pos
+			}
+			ahead := vecmath.Add(p, vecmath.Scale(velocities[e.id], leadTime))
+			if vecmath.LengthSq(vecmath.Sub(ahead, center)) <= r2 {
+				out = append(out, e.id)
+			}
+		}
+	})
+	return out
+}
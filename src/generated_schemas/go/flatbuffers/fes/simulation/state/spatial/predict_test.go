@@ -0,0 +1,80 @@
+package spatial
+
+import (
+	"slices"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+func TestQueryRadiusPredicted(t *testing.T) {
+	positions := map[uint32]vecmath.Vec2f{
+		1: {X: 100, Y: 0}, // fast, heading for the center
+		2: {X: 3, Y: 0},   // inside now, fleeing
+		3: {X: 2, Y: 2},   // inside, stationary
+		4: {X: 50, Y: 50}, // outside, stationary
+		5: {X: 0, Y: 8},   // outside now, drifting in slowly
+	}
+	velocities := map[uint32]vecmath.Vec2f{
+		1: {X: -200},
+		2: {X: 40},
+		5: {Y: -4},
+	}
+	h := NewSpatialHash(5)
+	h.Rebuild(positions)
+	center := vecmath.Vec2f{}
+
+	tests := []struct {
+		leadTime float32
+		want     []uint32
+	}{
+		{0, []uint32{2, 3}},
+		{0.5, []uint32{1, 3}},  // 1 reaches x=0 and 2 is at x=23; 5 is at y=6
+		{1, []uint32{3, 5}},    // 1 overshoots to x=-100; 5 reaches y=4
+		{-0.1, []uint32{2, 3}}, // looking back: 2 was at x=-1, 1 at x=120
+		{0.75, []uint32{3, 5}}, // 1 at x=-50, 5 at y=5 exactly
+	}
+	for _, tt := range tests {
+		got := sorted(QueryRadiusPredicted(h, positions, velocities, center, 5, tt.leadTime))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("leadTime %v: got %v, want %v", tt.leadTime, got, tt.want)
+		}
+	}
+}
+
+func TestQueryRadiusPredictedMatchesStatic(t *testing.T) {
+	positions := randomPositions(2000, 11)
+	velocities := make(map[uint32]vecmath.Vec2f, len(positions))
+	for id := range positions {
+		if id%3 != 0 {
+			velocities[id] = vecmath.Vec2f{X: float32(id%7) - 3, Y: float32(id%5) - 2}
+		}
+	}
+	still := make(map[uint32]vecmath.Vec2f, len(positions))
+	for id := range positions {
+		still[id] = vecmath.Vec2f{}
+	}
+	h := NewSpatialHash(20)
+	h.Rebuild(positions)
+
+	for _, c := range []vecmath.Vec2f{{X: 500, Y: 500}, {X: 0, Y: 0}, {X: 999, Y: 10}} {
+		for _, r := range []float32{0, 5, 20, 55} {
+			static := sorted(h.Neighbors(c, r))
+			if got := sorted(QueryRadiusPredicted(h, positions, velocities, c, r, 0)); !slices.Equal(got, static) {
+				t.Errorf("leadTime 0 at %v r=%v: got %d ids, want %d", c, r, len(got), len(static))
+			}
+			if got := sorted(QueryRadiusPredicted(h, positions, still, c, r, 3)); !slices.Equal(got, static) {
+				t.Errorf("zero velocities at %v r=%v: got %d ids, want %d", c, r, len(got), len(static))
+			}
+
+			ahead := make(map[uint32]vecmath.Vec2f, len(positions))
+			for id, p := range positions {
+				ahead[id] = vecmath.Add(p, vecmath.Scale(velocities[id], 4))
+			}
+			want := sorted(bruteRadius(ahead, c, r))
+			if got := sorted(QueryRadiusPredicted(h, positions, velocities, c, r, 4)); !slices.Equal(got, want) {
+				t.Errorf("leadTime 4 at %v r=%v: got %d ids, want %d", c, r, len(got), len(want))
+			}
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Package spatial provides broad-phase spatial indexes over entity positions,
+// so neighbor lookups do not have to compare every pair of entities.
+package spatial
+
+import (
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+// DefaultCapacity is the number of entries a quadtree node holds before it
+// subdivides, used when NewQuadtree is given a non-positive capacity.
+const DefaultCapacity = 8
+
+// maxDepth bounds subdivision so that many entities at the same position
+// cannot split a node forever; leaves at this depth simply grow past capacity.
+const maxDepth = 16
+
+type entry struct {
+	id  uint32
+	pos vecmath.Vec2f
+}
+
+type node struct {
+	bounds   vecmath.AABB
+	entries  []entry
+	children *[4]node
+	// count is the number of entries in this node's whole subtree.
+	count int
+}
+
+// Quadtree indexes entity positions within a fixed bounding box.
+//
+// Bounds are half-open like vecmath.AABB: positions on the max edges are
+// outside the tree. A Quadtree is not safe for concurrent mutation.
+type Quadtree struct {
+	root     node
+	capacity int
+	// positions maps each entity to its stored position so that Remove can
+	// descend directly to the owning leaf.
+	positions map[uint32]vecmath.Vec2f
+}
+
+// NewQuadtree returns an empty quadtree covering bounds whose nodes subdivide
+// once they exceed capacity entries.
+func NewQuadtree(bounds vecmath.AABB, capacity int) *Quadtree {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Quadtree{
+		root:      node{bounds: bounds},
+		capacity:  capacity,
+		positions: make(map[uint32]vecmath.Vec2f),
+	}
+}
+
+// Bounds returns the region covered by the tree.
+func (q *Quadtree) Bounds() vecmath.AABB {
+	return q.root.bounds
+}
+
+// Len returns the number of entities in the tree.
+func (q *Quadtree) Len() int {
+	return q.root.count
+}
+
+// Insert adds id at pos, replacing any position previously stored for id. It
+// returns false, leaving the tree unchanged, if pos is outside the bounds.
+func (q *Quadtree) Insert(id uint32, pos vecmath.Vec2f) bool {
+	if !q.root.bounds.Contains(pos) {
+		return false
+	}
+	if _, ok := q.positions[id]; ok {
+		q.Remove(id)
+	}
+	q.positions[id] = pos
+	q.root.insert(entry{id: id, pos: pos}, q.capacity, 0)
+	return true
+}
+
+// Remove deletes id from the tree and reports whether it was present. Nodes
+// whose subtree falls back within capacity are collapsed into a single leaf.
+func (q *Quadtree) Remove(id uint32) bool {
+	pos, ok := q.positions[id]
+	if !ok {
+		return false
+	}
+	delete(q.positions, id)
+	q.root.remove(id, pos, q.capacity)
+	return true
+}
+
+// QueryRange returns the IDs of all entities inside r, in no particular order.
+func (q *Quadtree) QueryRange(r vecmath.AABB) []uint32 {
+	return q.QueryRangeInto(r, nil)
+}
+
+// QueryRangeInto is QueryRange appending into dst[:0] rather than a new
+// slice, so a caller reusing one scratch buffer across queries allocates only
+// when a result outgrows it. The returned slice aliases dst whenever it fits,
+// and so is overwritten by the next query into the same buffer.
+func (q *Quadtree) QueryRangeInto(r vecmath.AABB, dst []uint32) []uint32 {
+	out := dst[:0]
+	q.root.queryRange(r, &out)
+	return out
+}
+
+// QueryRadius returns the IDs of all entities within distance r of center,
+// inclusive, in no particular order. Nodes whose bounds do not reach the
+// query circle are skipped without visiting their entries.
+func (q *Quadtree) QueryRadius(center vecmath.Vec2f, r float32) []uint32 {
+	return q.QueryRadiusInto(center, r, nil)
+}
+
+// QueryRadiusInto is QueryRadius appending into dst[:0]; the returned slice
+// aliases dst as for QueryRangeInto.
+func (q *Quadtree) QueryRadiusInto(center vecmath.Vec2f, r float32, dst []uint32) []uint32 {
+	out := dst[:0]
+	if r < 0 {
+		return out
+	}
+	q.root.queryRadius(center, r, r*r, &out)
+	return out
+}
+
+func (n *node) insert(e entry, capacity, depth int) {
+	n.count++
+	if n.children != nil {
+		n.child(e.pos).insert(e, capacity, depth+1)
+		return
+	}
+	n.entries = append(n.entries, e)
+	if len(n.entries) > capacity && depth < maxDepth {
+		n.subdivide(capacity, depth)
+	}
+}
+
+func (n *node) subdivide(capacity, depth int) {
+	lo, hi := n.bounds.Min, n.bounds.Max
+	mid := vecmath.Scale(vecmath.Add(lo, hi), 0.5)
+	n.children = &[4]node{
+		{bounds: vecmath.AABB{Min: lo, Max: mid}},
+		{bounds: vecmath.AABB{Min: vecmath.Vec2f{X: mid.X, Y: lo.Y}, Max: vecmath.Vec2f{X: hi.X, Y: mid.Y}}},
+		{bounds: vecmath.AABB{Min: vecmath.Vec2f{X: lo.X, Y: mid.Y}, Max: vecmath.Vec2f{X: mid.X, Y: hi.Y}}},
+		{bounds: vecmath.AABB{Min: mid, Max: hi}},
+	}
+	entries := n.entries
+	n.entries = nil
+	for _, e := range entries {
+		n.child(e.pos).insert(e, capacity, depth+1)
+	}
+}
+
+// child returns the quadrant containing p. Quadrants split at the midpoint
+// with the midpoint itself going to the upper quadrant, matching the
+// half-open convention.
+func (n *node) child(p vecmath.Vec2f) *node {
+	mid := vecmath.Scale(vecmath.Add(n.bounds.Min, n.bounds.Max), 0.5)
+	i := 0
+	if p.X >= mid.X {
+		i |= 1
+	}
+	if p.Y >= mid.Y {
+		i |= 2
+	}
+	return &n.children[i]
+}
+
+func (n *node) remove(id uint32, pos vecmath.Vec2f, capacity int) {
+	n.count--
+	if n.children == nil {
+		for i, e := range n.entries {
+			if e.id == id {
+				last := len(n.entries) - 1
+				n.entries[i] = n.entries[last]
+				n.entries = n.entries[:last]
+				break
+			}
+		}
+		return
+	}
+	n.child(pos).remove(id, pos, capacity)
+	if n.count <= capacity {
+		n.collapse()
+	}
+}
+
+// collapse pulls every entry in the subtree up into n and drops its children.
+func (n *node) collapse() {
+	entries := make([]entry, 0, n.count)
+	n.collect(&entries)
+	n.entries = entries
+	n.children = nil
+}
+
+func (n *node) collect(out *[]entry) {
+	*out = append(*out, n.entries...)
+	if n.children != nil {
+		for i := range n.children {
+			n.children[i].collect(out)
+		}
+	}
+}
+
+func (n *node) queryRange(r vecmath.AABB, out *[]uint32) {
+	if n.count == 0 || !n.bounds.Intersects(r) {
+		return
+	}
+	for _, e := range n.entries {
+		if r.Contains(e.pos) {
+			*out = append(*out, e.id)
+		}
+	}
+	if n.children != nil {
+		for i := range n.children {
+			n.children[i].queryRange(r, out)
+		}
+	}
+}
+
+func (n *node) queryRadius(center vecmath.Vec2f, r, r2 float32, out *[]uint32) {
+	if n.count == 0 || !n.bounds.IntersectsCircle(center, r) {
+		return
+	}
+	for _, e := range n.entries {
+		if vecmath.LengthSq(vecmath.Sub(e.pos, center)) <= r2 {
+			*out = append(*out, e.id)
+		}
+	}
+	if n.children != nil {
+		for i := range n.children {
+			n.children[i].queryRadius(center, r, r2, out)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package spatial
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state/vecmath"
+)
+
+var worldBounds = vecmath.AABB{Max: vecmath.Vec2f{X: 1000, Y: 1000}}
+
+func randomPositions(n int, seed uint64) map[uint32]vecmath.Vec2f {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	positions := make(map[uint32]vecmath.Vec2f, n)
+	for i := range n {
+		positions[uint32(i)] = vecmath.Vec2f{
+			X: rng.Float32() * worldBounds.Max.X,
+			Y: rng.Float32() * worldBounds.Max.Y,
+		}
+	}
+	return positions
+}
+
+func bruteRadius(positions map[uint32]vecmath.Vec2f, center vecmath.Vec2f, r float32) []uint32 {
+	var out []uint32
+	for id, p := range positions {
+		if vecmath.LengthSq(vecmath.Sub(p, center)) <= r*r {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func bruteRange(positions map[uint32]vecmath.Vec2f, r vecmath.AABB) []uint32 {
+	var out []uint32
+	for id, p := range positions {
+		if r.Contains(p) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func sorted(ids []uint32) []uint32 {
+	slices.Sort(ids)
+	return ids
+}
+
+func TestQuadtreeMatchesBruteForce(t *testing.T) {
+	positions := randomPositions(2000, 1)
+	q := NewQuadtree(worldBounds, 4)
+	for id, p := range positions {
+		if !q.Insert(id, p) {
+			t.Fatalf("Insert(%d, %v) rejected", id, p)
+		}
+	}
+	if q.Len() != len(positions) {
+		t.Fatalf("Len = %d, want %d", q.Len(), len(positions))
+	}
+
+	centers := []vecmath.Vec2f{{X: 500, Y: 500}, {X: 0, Y: 0}, {X: 999, Y: 10}, {X: -50, Y: 500}}
+	for _, c := range centers {
+		for _, r := range []float32{0, 10, 75, 400} {
+			got := sorted(q.QueryRadius(c, r))
+			want := sorted(bruteRadius(positions, c, r))
+			if !slices.Equal(got, want) {
+				t.Errorf("QueryRadius(%v, %v): got %d ids, want %d", c, r, len(got), len(want))
+			}
+		}
+	}
+
+	ranges := []vecmath.AABB{
+		{Min: vecmath.Vec2f{X: 100, Y: 100}, Max: vecmath.Vec2f{X: 300, Y: 250}},
+		{Min: vecmath.Vec2f{X: -10, Y: -10}, Max: vecmath.Vec2f{X: 2000, Y: 2000}},
+		{Min: vecmath.Vec2f{X: 500, Y: 500}, Max: vecmath.Vec2f{X: 500, Y: 600}},
+	}
+	for _, r := range ranges {
+		got := sorted(q.QueryRange(r))
+		want := sorted(bruteRange(positions, r))
+		if !slices.Equal(got, want) {
+			t.Errorf("QueryRange(%v): got %d ids, want %d", r, len(got), len(want))
+		}
+	}
+}
+
+func TestQuadtreeInsertOutOfBounds(t *testing.T) {
+	q := NewQuadtree(worldBounds, 4)
+	if q.Insert(1, vecmath.Vec2f{X: 1000, Y: 5}) {
+		t.Error("Insert on the max edge must be rejected")
+	}
+	if q.Insert(2, vecmath.Vec2f{X: -1, Y: 5}) {
+		t.Error("Insert outside the bounds must be rejected")
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len = %d, want 0", q.Len())
+	}
+}
+
+func TestQuadtreeReinsertMoves(t *testing.T) {
+	q := NewQuadtree(worldBounds, 1)
+	q.Insert(1, vecmath.Vec2f{X: 10, Y: 10})
+	q.Insert(2, vecmath.Vec2f{X: 900, Y: 900})
+	q.Insert(1, vecmath.Vec2f{X: 890, Y: 890})
+
+	if q.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", q.Len())
+	}
+	if got := sorted(q.QueryRadius(vecmath.Vec2f{X: 895, Y: 895}, 20)); !slices.Equal(got, []uint32{1, 2}) {
+		t.Errorf("QueryRadius = %v, want [1 2]", got)
+	}
+	if got := q.QueryRadius(vecmath.Vec2f{X: 10, Y: 10}, 1); len(got) != 0 {
+		t.Errorf("stale position still indexed: %v", got)
+	}
+}
+
+func TestQuadtreeRemoveCollapses(t *testing.T) {
+	positions := randomPositions(200, 2)
+	q := NewQuadtree(worldBounds, 4)
+	for id, p := range positions {
+		q.Insert(id, p)
+	}
+	if q.root.children == nil {
+		t.Fatal("root did not subdivide")
+	}
+
+	for id := range positions {
+		if id >= 3 {
+			if !q.Remove(id) {
+				t.Fatalf("Remove(%d) = false", id)
+			}
+		}
+	}
+	if q.Remove(100) {
+		t.Error("Remove of an absent id must return false")
+	}
+	if q.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", q.Len())
+	}
+	if q.root.children != nil {
+		t.Error("root was not collapsed back into a leaf")
+	}
+	if got := sorted(q.QueryRange(worldBounds)); !slices.Equal(got, []uint32{0, 1, 2}) {
+		t.Errorf("QueryRange = %v, want [0 1 2]", got)
+	}
+}
+
+func TestQuadtreeCoincidentPoints(t *testing.T) {
+	q := NewQuadtree(worldBounds, 2)
+	p := vecmath.Vec2f{X: 123, Y: 456}
+	for id := range uint32(50) {
+		q.Insert(id, p)
+	}
+	if got := q.QueryRadius(p, 0); len(got) != 50 {
+		t.Fatalf("QueryRadius found %d coincident entities, want 50", len(got))
+	}
+}
+
+func BenchmarkQueryRadius10k(b *testing.B) {
+	positions := randomPositions(10000, 3)
+	centers := randomPositions(256, 4)
+	const r = 25
+
+	b.Run("quadtree", func(b *testing.B) {
+		q := NewQuadtree(worldBounds, DefaultCapacity)
+		for id, p := range positions {
+			q.Insert(id, p)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q.QueryRadius(centers[uint32(i%len(centers))], r)
+		}
+	})
+
+	b.Run("bruteforce", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bruteRadius(positions, centers[uint32(i%len(centers))], r)
+		}
+	})
+}
+
+// checkQueryIntoReusesBuffer runs a range and a radius query over an index of
+// positions through a buffer with stale contents, and checks both against
+// brute force.
+func checkQueryIntoReusesBuffer(t *testing.T, positions map[uint32]vecmath.Vec2f,
+	rangeInto func(vecmath.AABB, []uint32) []uint32, radiusInto func(vecmath.Vec2f, float32, []uint32) []uint32) {
+	t.Helper()
+	box := vecmath.AABB{Min: vecmath.Vec2f{X: 100, Y: 200}, Max: vecmath.Vec2f{X: 180, Y: 260}}
+	center := vecmath.Vec2f{X: 400, Y: 400}
+
+	buf := []uint32{99999, 99998}
+	buf = rangeInto(box, buf)
+	if want := sorted(bruteRange(positions, box)); !slices.Equal(sorted(slices.Clone(buf)), want) {
+		t.Errorf("range: got %d ids, want %d", len(buf), len(want))
+	}
+	buf = radiusInto(center, 40, buf)
+	if want := sorted(bruteRadius(positions, center, 40)); !slices.Equal(sorted(slices.Clone(buf)), want) {
+		t.Errorf("radius: got %d ids, want %d", len(buf), len(want))
+	}
+}
+
+// checkQueryRadiusIntoDoesNotAllocate checks that radius queries through a
+// buffer with enough capacity make no allocations.
+func checkQueryRadiusIntoDoesNotAllocate(t *testing.T, radiusInto func(vecmath.Vec2f, float32, []uint32) []uint32) {
+	t.Helper()
+	centers := slices.Collect(maps.Values(randomPositions(64, 14)))
+	buf := make([]uint32, 0, 1024)
+	allocs := testing.AllocsPerRun(5, func() {
+		for _, c := range centers {
+			buf = radiusInto(c, 25, buf)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("%v allocations per run of %d queries, want 0", allocs, len(centers))
+	}
+}
+
+func TestQuadtreeQueryIntoReusesBuffer(t *testing.T) {
+	positions := randomPositions(3000, 12)
+	q := NewQuadtree(worldBounds, DefaultCapacity)
+	for id, p := range positions {
+		q.Insert(id, p)
+	}
+	checkQueryIntoReusesBuffer(t, positions, q.QueryRangeInto, q.QueryRadiusInto)
+}
+
+func TestQuadtreeQueryIntoDoesNotAllocate(t *testing.T) {
+	q := NewQuadtree(worldBounds, DefaultCapacity)
+	for id, p := range randomPositions(10000, 13) {
+		q.Insert(id, p)
+	}
+	checkQueryRadiusIntoDoesNotAllocate(t, q.QueryRadiusInto)
+}
+
+// BenchmarkQueryRadiusInto10k runs 10k radius queries per iteration through a
+// single reused buffer; after the first iteration it should report 0
+// allocs/op.
+func BenchmarkQueryRadiusInto10k(b *testing.B) {
+	positions := randomPositions(10000, 3)
+	centers := slices.Collect(maps.Values(randomPositions(10000, 4)))
+	const r = 25
+
+	q := NewQuadtree(worldBounds, DefaultCapacity)
+	for id, p := range positions {
+		q.Insert(id, p)
+	}
+	h := NewSpatialHash(r)
+	h.Rebuild(positions)
+
+	b.Run("quadtree", func(b *testing.B) {
+		var buf []uint32
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, c := range centers {
+				buf = q.QueryRadiusInto(c, r, buf)
+			}
+		}
+	})
+	b.Run("hash", func(b *testing.B) {
+		var buf []uint32
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, c := range centers {
+				buf = h.QueryRadiusInto(c, r, buf)
+			}
+		}
+	})
+	b.Run("quadtree/alloc", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, c := range centers {
+				q.QueryRadius(c, r)
+			}
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package vecmath
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// AABB is an axis-aligned bounding box held by value. It follows the same
+// half-open convention and min <= max invariant as state.AABB: the min edge is
+// inside the box, the max edge is not, and an inverted box is empty.
+type AABB struct {
+	Min, Max Vec2f
+}
+
+// Contains reports whether p lies inside the box.
+func (b AABB) Contains(p Vec2f) bool {
+	return p.X >= b.Min.X && p.X < b.Max.X && p.Y >= b.Min.Y && p.Y < b.Max.Y
+}
+
+// Intersects reports whether b and other overlap by a non-zero area. Boxes
+// that only share an edge or a corner do not intersect.
+func (b AABB) Intersects(other AABB) bool {
+	if b.Empty() || other.Empty() {
+		return false
+	}
+	return b.Min.X < other.Max.X && other.Min.X < b.Max.X &&
+		b.Min.Y < other.Max.Y && other.Min.Y < b.Max.Y
+}
+
+// IntersectsCircle reports whether any part of the box lies within r of
+// center. It is conservative on the max edges: a circle that only reaches the
+// excluded max edge still counts, which is harmless for pruning.
+func (b AABB) IntersectsCircle(center Vec2f, r float32) bool {
+	if b.Empty() || r < 0 {
+		return false
+	}
+	closest := Vec2f{X: clamp(center.X, b.Min.X, b.Max.X), Y: clamp(center.Y, b.Min.Y, b.Max.Y)}
+	return LengthSq(Sub(center, closest)) <= r*r
+}
+
+// Empty reports whether the box encloses no area.
+func (b AABB) Empty() bool {
+	return b.Min.X >= b.Max.X || b.Min.Y >= b.Max.Y
+}
+
+// AABBFromFB copies a FlatBuffers AABB into a value. A nil accessor yields the
+// zero (empty) box.
+func AABBFromFB(b *state.AABB) AABB {
+	if b == nil {
+		return AABB{}
+	}
+	return AABB{Min: FromFB(b.Min(nil)), Max: FromFB(b.Max(nil))}
+}
+
+// AABBToFB writes b into builder as an inline FlatBuffers AABB struct and
+// returns its offset, for use with the generated Add<Field> helpers.
+func AABBToFB(builder *flatbuffers.Builder, b AABB) flatbuffers.UOffsetT {
+	return state.CreateAABB(builder, b.Min.X, b.Min.Y, b.Max.X, b.Max.Y)
+}
+
+func clamp(v, lo, hi float32) float32 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// ClampToBounds returns v with each component clamped independently into
+// [bounds.Min, bounds.Max]. The range is inclusive, so a clamped point may sit
+// exactly on a max edge, which Contains treats as outside the box.
+func ClampToBounds(v Vec2f, bounds AABB) Vec2f {
+	return Vec2f{
+		X: clamp(v.X, bounds.Min.X, bounds.Max.X),
+		Y: clamp(v.Y, bounds.Min.Y, bounds.Max.Y),
+	}
+}
+
+// ReflectAtBounds bounces an entity off the walls of bounds. On each axis
+// where pos has crossed a wall, the overshoot is mirrored back inside and the
+// velocity component is pointed away from that wall; an entity sitting exactly
+// on a wall and moving into it is turned around in place.
+//
+// The axes are handled independently and each velocity component's sign is
+// set rather than negated, so a corner hit reflects both components exactly
+// once. An overshoot larger than the box is clamped to the far wall.
+func ReflectAtBounds(pos, vel Vec2f, bounds AABB) (Vec2f, Vec2f) {
+	pos.X, vel.X = reflectAxis(pos.X, vel.X, bounds.Min.X, bounds.Max.X)
+	pos.Y, vel.Y = reflectAxis(pos.Y, vel.Y, bounds.Min.Y, bounds.Max.Y)
+	return pos, vel
+}
+
+func reflectAxis(p, v, lo, hi float32) (float32, float32) {
+	switch {
+	case p < lo:
+		p, v = 2*lo-p, abs32(v)
+	case p > hi:
+		p, v = 2*hi-p, -abs32(v)
+	case p == lo && v < 0, p == hi && v > 0:
+		v = -v
+	}
+	return clamp(p, lo, hi), v
+}
+
+func abs32(f float32) float32 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
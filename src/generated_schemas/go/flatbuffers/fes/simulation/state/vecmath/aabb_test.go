@@ -0,0 +1,81 @@
+package vecmath
+
+import "testing"
+
+func TestAABBIntersectsCircle(t *testing.T) {
+	box := AABB{Min: Vec2f{X: 0, Y: 0}, Max: Vec2f{X: 10, Y: 10}}
+	tests := []struct {
+		name   string
+		center Vec2f
+		r      float32
+		want   bool
+	}{
+		{"inside", Vec2f{X: 5, Y: 5}, 1, true},
+		{"overlapping side", Vec2f{X: 12, Y: 5}, 3, true},
+		{"touching side", Vec2f{X: 12, Y: 5}, 2, true},
+		{"short of side", Vec2f{X: 12, Y: 5}, 1.9, false},
+		{"near corner miss", Vec2f{X: 12, Y: 12}, 2.5, false},
+		{"near corner hit", Vec2f{X: 12, Y: 12}, 3, true},
+		{"negative radius", Vec2f{X: 5, Y: 5}, -1, false},
+	}
+	for _, tt := range tests {
+		if got := box.IntersectsCircle(tt.center, tt.r); got != tt.want {
+			t.Errorf("%s: IntersectsCircle = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAABBContainsHalfOpen(t *testing.T) {
+	box := AABB{Min: Vec2f{X: 0, Y: 0}, Max: Vec2f{X: 1, Y: 1}}
+	if !box.Contains(Vec2f{}) {
+		t.Error("min corner must be inside")
+	}
+	if box.Contains(Vec2f{X: 1, Y: 0.5}) {
+		t.Error("max edge must be outside")
+	}
+	if (AABB{Min: Vec2f{X: 1, Y: 1}}).Contains(Vec2f{X: 0.5, Y: 0.5}) {
+		t.Error("inverted box must be empty")
+	}
+}
+
+var tank = AABB{Min: Vec2f{X: 0, Y: 0}, Max: Vec2f{X: 10, Y: 20}}
+
+func TestClampToBounds(t *testing.T) {
+	tests := []struct {
+		in, want Vec2f
+	}{
+		{Vec2f{X: 5, Y: 5}, Vec2f{X: 5, Y: 5}},
+		{Vec2f{X: -1, Y: 5}, Vec2f{X: 0, Y: 5}},
+		{Vec2f{X: 11, Y: 25}, Vec2f{X: 10, Y: 20}},
+		{Vec2f{X: -3, Y: -3}, Vec2f{X: 0, Y: 0}},
+		{Vec2f{X: 0, Y: 20}, Vec2f{X: 0, Y: 20}},
+		{Vec2f{X: 10, Y: 0}, Vec2f{X: 10, Y: 0}},
+	}
+	for _, tt := range tests {
+		if got := ClampToBounds(tt.in, tank); got != tt.want {
+			t.Errorf("ClampToBounds(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestReflectAtBounds(t *testing.T) {
+	tests := []struct {
+		name             string
+		pos, vel         Vec2f
+		wantPos, wantVel Vec2f
+	}{
+		{"inside", Vec2f{X: 5, Y: 5}, Vec2f{X: 1, Y: -1}, Vec2f{X: 5, Y: 5}, Vec2f{X: 1, Y: -1}},
+		{"past right wall", Vec2f{X: 12, Y: 5}, Vec2f{X: 3, Y: 1}, Vec2f{X: 8, Y: 5}, Vec2f{X: -3, Y: 1}},
+		{"past left wall", Vec2f{X: -2, Y: 5}, Vec2f{X: -3, Y: 1}, Vec2f{X: 2, Y: 5}, Vec2f{X: 3, Y: 1}},
+		{"corner", Vec2f{X: 11, Y: -1}, Vec2f{X: 2, Y: -2}, Vec2f{X: 9, Y: 1}, Vec2f{X: -2, Y: 2}},
+		{"on wall moving out", Vec2f{X: 10, Y: 20}, Vec2f{X: 1, Y: 1}, Vec2f{X: 10, Y: 20}, Vec2f{X: -1, Y: -1}},
+		{"on wall moving in", Vec2f{X: 0, Y: 5}, Vec2f{X: 1, Y: 0}, Vec2f{X: 0, Y: 5}, Vec2f{X: 1, Y: 0}},
+		{"overshoot wider than box", Vec2f{X: 35, Y: 5}, Vec2f{X: 1, Y: 0}, Vec2f{X: 0, Y: 5}, Vec2f{X: -1, Y: 0}},
+	}
+	for _, tt := range tests {
+		pos, vel := ReflectAtBounds(tt.pos, tt.vel, tank)
+		if pos != tt.wantPos || vel != tt.wantVel {
+			t.Errorf("%s: got (%v, %v), want (%v, %v)", tt.name, pos, vel, tt.wantPos, tt.wantVel)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package vecmath
+
+// Accumulator sums vectors in float64 for centroids and averages. Summing
+// thousands of float32 positions in float32 loses the low bits of each
+// addend once the running total grows; float64 keeps them. The zero value is
+// an empty accumulator ready to use.
+type Accumulator struct {
+	x, y  float64
+	count int
+}
+
+// Add adds v to the running sum.
+func (a *Accumulator) Add(v Vec2f) {
+	a.x += float64(v.X)
+	a.y += float64(v.Y)
+	a.count++
+}
+
+// Sum returns the total of every vector added, rounded to float32.
+func (a *Accumulator) Sum() Vec2f {
+	return Vec2f{X: float32(a.x), Y: float32(a.y)}
+}
+
+// Mean returns the average of every vector added. An empty accumulator
+// returns the zero vector.
+func (a *Accumulator) Mean() Vec2f {
+	if a.count == 0 {
+		return Vec2f{}
+	}
+	n := float64(a.count)
+	return Vec2f{X: float32(a.x / n), Y: float32(a.y / n)}
+}
+
+// Count returns the number of vectors added.
+func (a *Accumulator) Count() int {
+	return a.count
+}
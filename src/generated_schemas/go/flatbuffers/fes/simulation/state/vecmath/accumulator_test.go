@@ -0,0 +1,45 @@
+package vecmath
+
+import "testing"
+
+func TestAccumulator(t *testing.T) {
+	var a Accumulator
+	if a.Count() != 0 || a.Mean() != (Vec2f{}) || a.Sum() != (Vec2f{}) {
+		t.Fatalf("empty accumulator: count %d, mean %v, sum %v", a.Count(), a.Mean(), a.Sum())
+	}
+	for _, v := range []Vec2f{{X: 1, Y: 2}, {X: 3, Y: -4}, {X: -1, Y: 5}} {
+		a.Add(v)
+	}
+	if a.Count() != 3 || a.Sum() != (Vec2f{X: 3, Y: 3}) || a.Mean() != (Vec2f{X: 1, Y: 1}) {
+		t.Fatalf("count %d, sum %v, mean %v", a.Count(), a.Sum(), a.Mean())
+	}
+}
+
+func TestAccumulatorPrecision(t *testing.T) {
+	// One far-away fish followed by 10k near the origin: once the float32
+	// running total reaches 2^24 its spacing is 2, so each 0.5 is lost.
+	// Every value here is exact in float32, so the true sum is known.
+	const n = 10000
+	big := Vec2f{X: 1 << 24, Y: -(1 << 24)}
+	small := Vec2f{X: 0.5, Y: -0.5}
+
+	var a Accumulator
+	naive := big
+	a.Add(big)
+	for range n {
+		a.Add(small)
+		naive = Add(naive, small)
+	}
+
+	want := Vec2f{X: 1<<24 + n*0.5, Y: -(1<<24 + n*0.5)}
+	if got := a.Sum(); got != want {
+		t.Errorf("Accumulator sum = %v, want %v", got, want)
+	}
+	if naive != big {
+		t.Fatalf("naive float32 sum = %v, expected it to stall at %v", naive, big)
+	}
+	wantMean := Vec2f{X: float32(want.X / (n + 1)), Y: float32(want.Y / (n + 1))}
+	if got := a.Mean(); Distance(got, wantMean) > 1e-3 {
+		t.Errorf("Mean = %v, want %v", got, wantMean)
+	}
+}
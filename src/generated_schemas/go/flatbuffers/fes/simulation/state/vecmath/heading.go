@@ -0,0 +1,32 @@
+package vecmath
+
+import "math"
+
+// Heading returns the direction of vel in radians, in [-π, π], measured
+// counter-clockwise from the +X axis as atan2(y, x).
+//
+// A zero velocity, including negative zeros, has no direction and returns 0
+// (facing +X) instead of the ±π that atan2 gives for signed zeros, so a
+// stationary fish keeps a stable sprite orientation.
+func Heading(vel Vec2f) float32 {
+	if vel.X == 0 && vel.Y == 0 {
+		return 0
+	}
+	return float32(math.Atan2(float64(vel.Y), float64(vel.X)))
+}
+
+// HeadingDegrees is Heading in degrees, in [-180, 180].
+func HeadingDegrees(vel Vec2f) float32 {
+	if vel.X == 0 && vel.Y == 0 {
+		return 0
+	}
+	return float32(math.Atan2(float64(vel.Y), float64(vel.X)) * 180 / math.Pi)
+}
+
+// FromHeading returns the velocity with the given heading in radians and
+// speed, the inverse of Heading and Length. A negative speed points the
+// velocity the opposite way.
+func FromHeading(angle, speed float32) Vec2f {
+	sin, cos := math.Sincos(float64(angle))
+	return Vec2f{X: float32(cos * float64(speed)), Y: float32(sin * float64(speed))}
+}
@@ -0,0 +1,54 @@
+package vecmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHeadingCardinal(t *testing.T) {
+	tests := []struct {
+		vel     Vec2f
+		radians float32
+		degrees float32
+	}{
+		{Vec2f{X: 2}, 0, 0},
+		{Vec2f{Y: 2}, math.Pi / 2, 90},
+		{Vec2f{X: -2}, math.Pi, 180},
+		{Vec2f{Y: -2}, -math.Pi / 2, -90},
+	}
+	for _, tt := range tests {
+		if got := Heading(tt.vel); got != tt.radians {
+			t.Errorf("Heading(%v) = %v, want %v", tt.vel, got, tt.radians)
+		}
+		if got := HeadingDegrees(tt.vel); got != tt.degrees {
+			t.Errorf("HeadingDegrees(%v) = %v, want %v", tt.vel, got, tt.degrees)
+		}
+	}
+}
+
+func TestHeadingZeroVelocity(t *testing.T) {
+	negZero := float32(math.Copysign(0, -1))
+	for _, v := range []Vec2f{{}, {X: negZero}, {Y: negZero}, {X: negZero, Y: negZero}} {
+		if got := Heading(v); got != 0 {
+			t.Errorf("Heading(%v) = %v, want 0", v, got)
+		}
+		if got := HeadingDegrees(v); got != 0 {
+			t.Errorf("HeadingDegrees(%v) = %v, want 0", v, got)
+		}
+	}
+}
+
+func TestFromHeadingRoundTrip(t *testing.T) {
+	for _, angle := range []float32{0, 0.5, math.Pi / 2, 2, -1, -math.Pi / 2} {
+		v := FromHeading(angle, 3)
+		if got := Heading(v); math.Abs(float64(got-angle)) > 1e-6 {
+			t.Errorf("Heading(FromHeading(%v, 3)) = %v", angle, got)
+		}
+		if got := Length(v); math.Abs(float64(got-3)) > 1e-6 {
+			t.Errorf("Length(FromHeading(%v, 3)) = %v", angle, got)
+		}
+	}
+	if got := FromHeading(math.Pi/2, 0); got != (Vec2f{}) {
+		t.Errorf("FromHeading with zero speed = %v, want zero", got)
+	}
+}
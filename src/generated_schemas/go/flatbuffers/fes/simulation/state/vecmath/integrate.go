@@ -0,0 +1,37 @@
+package vecmath
+
+import "math"
+
+// MaxSubsteps caps the number of substeps Integrate takes for one call. When
+// dt/maxStep would need more, Integrate takes exactly MaxSubsteps equal steps
+// and each is longer than maxStep, trading tunneling safety for a bounded
+// cost. A dt that large usually means the simulation stalled.
+const MaxSubsteps = 1024
+
+// Integrate advances pos by vel over dt, splitting dt into equal substeps no
+// longer than maxStep (but at most MaxSubsteps of them) so that a future
+// collision check per substep cannot tunnel through thin barriers. It returns
+// the final position.
+//
+// dt == 0 returns pos unchanged. maxStep <= 0 disables sub-stepping. A
+// negative dt integrates backwards in time.
+func Integrate(pos, vel Vec2f, dt, maxStep float32) Vec2f {
+	if dt == 0 {
+		return pos
+	}
+	n := 1
+	if maxStep > 0 {
+		steps := math.Ceil(math.Abs(float64(dt)) / float64(maxStep))
+		// !(steps <= MaxSubsteps) also catches NaN.
+		if !(steps <= MaxSubsteps) {
+			steps = MaxSubsteps
+		}
+		n = max(int(steps), 1)
+	}
+
+	step := Scale(vel, dt/float32(n))
+	for range n {
+		pos = Add(pos, step)
+	}
+	return pos
+}
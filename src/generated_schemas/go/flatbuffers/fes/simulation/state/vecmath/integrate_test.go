@@ -0,0 +1,46 @@
+package vecmath
+
+import "testing"
+
+func TestIntegrateZeroDt(t *testing.T) {
+	pos := Vec2f{X: 3, Y: -1}
+	if got := Integrate(pos, Vec2f{X: 100, Y: 100}, 0, 0.1); got != pos {
+		t.Errorf("Integrate with dt=0 = %v, want %v", got, pos)
+	}
+}
+
+func TestIntegrateSingleMatchesMultiStep(t *testing.T) {
+	pos := Vec2f{X: 1, Y: 2}
+	vel := Vec2f{X: 3, Y: -4}
+
+	// Power-of-two step sizes keep every substep exact in float32.
+	single := Integrate(pos, vel, 2, 0)
+	multi := Integrate(pos, vel, 2, 0.25)
+	if want := (Vec2f{X: 7, Y: -6}); single != want || multi != want {
+		t.Errorf("single = %v, multi = %v, want %v", single, multi, want)
+	}
+
+	// Otherwise the results agree to rounding.
+	single = Integrate(pos, vel, 0.7, 0)
+	multi = Integrate(pos, vel, 0.7, 0.03)
+	if d := Distance(single, multi); d > 1e-5 {
+		t.Errorf("single %v and multi %v differ by %v", single, multi, d)
+	}
+}
+
+func TestIntegrateNegativeDt(t *testing.T) {
+	got := Integrate(Vec2f{}, Vec2f{X: 1}, -1, 0.25)
+	if got != (Vec2f{X: -1}) {
+		t.Errorf("Integrate backwards = %v, want {-1 0}", got)
+	}
+}
+
+func TestIntegrateCapsSubsteps(t *testing.T) {
+	vel := Vec2f{X: 1}
+	// 1024 / 1e-6 would be about 1e9 substeps without the cap. With it the
+	// call takes MaxSubsteps steps of exactly 1.
+	got := Integrate(Vec2f{}, vel, MaxSubsteps, 1e-6)
+	if got != (Vec2f{X: 1024}) {
+		t.Errorf("capped Integrate = %v, want {1024 0}", got)
+	}
+}
@@ -0,0 +1,39 @@
+package vecmath
+
+import "math"
+
+// Quantize maps v into int16 fixed-point by multiplying each component by
+// scale (units per world unit) and rounding, for packing into a state.Vec2s.
+//
+// The result is deterministic across platforms: the product of two float32
+// values is exact in float64, rounding is half-to-even, and components beyond
+// the int16 range are clamped to its bounds instead of wrapping. NaN
+// quantizes to 0. The largest representable world coordinate is therefore
+// ±32767/scale; at scale 8, for example, positions stay exact to 1/16 of a
+// unit across ±4095.875.
+func Quantize(v Vec2f, scale float32) (int16, int16) {
+	return quantize(v.X, scale), quantize(v.Y, scale)
+}
+
+// Dequantize is the inverse of Quantize, returning the world position at the
+// centre of the quantization step (x, y).
+func Dequantize(x, y int16, scale float32) Vec2f {
+	return Vec2f{X: dequantize(x, scale), Y: dequantize(y, scale)}
+}
+
+func quantize(c, scale float32) int16 {
+	f := math.RoundToEven(float64(c) * float64(scale))
+	switch {
+	case f != f: // NaN
+		return 0
+	case f >= math.MaxInt16:
+		return math.MaxInt16
+	case f <= math.MinInt16:
+		return math.MinInt16
+	}
+	return int16(f)
+}
+
+func dequantize(q int16, scale float32) float32 {
+	return float32(float64(q) / float64(scale))
+}
@@ -0,0 +1,58 @@
+package vecmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuantizeRounding(t *testing.T) {
+	tests := []struct {
+		c     float32
+		scale float32
+		want  int16
+	}{
+		{0.5, 1, 0},
+		{1.5, 1, 2},
+		{2.5, 1, 2},
+		{-0.5, 1, 0},
+		{-1.5, 1, -2},
+		{0.25, 2, 0},
+		{0.75, 2, 2},
+		{1.2, 10, 12},
+		{-3.3, 10, -33},
+	}
+	for _, tt := range tests {
+		if got, _ := Quantize(Vec2f{X: tt.c}, tt.scale); got != tt.want {
+			t.Errorf("Quantize(%v, %v) = %d, want %d", tt.c, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestQuantizeClamps(t *testing.T) {
+	x, y := Quantize(Vec2f{X: 1e6, Y: -1e6}, 1)
+	if x != math.MaxInt16 || y != math.MinInt16 {
+		t.Fatalf("Quantize out of range = (%d, %d), want (%d, %d)", x, y, math.MaxInt16, math.MinInt16)
+	}
+	x, y = Quantize(Vec2f{X: float32(math.Inf(1)), Y: float32(math.NaN())}, 1)
+	if x != math.MaxInt16 || y != 0 {
+		t.Fatalf("Quantize(Inf, NaN) = (%d, %d), want (%d, 0)", x, y, math.MaxInt16)
+	}
+}
+
+func TestQuantizeRoundTrip(t *testing.T) {
+	const scale = 8
+	for q := int16(-2000); q <= 2000; q += 7 {
+		v := Dequantize(q, -q, scale)
+		x, y := Quantize(v, scale)
+		if x != q || y != -q {
+			t.Fatalf("round trip of %d gave (%d, %d)", q, x, y)
+		}
+	}
+
+	v := Vec2f{X: 123.456, Y: -987.654}
+	qx, qy := Quantize(v, scale)
+	got := Dequantize(qx, qy, scale)
+	if math.Abs(float64(got.X-v.X)) > 0.5/scale || math.Abs(float64(got.Y-v.Y)) > 0.5/scale {
+		t.Fatalf("Dequantize(Quantize(%v)) = %v, error exceeds half a step", v, got)
+	}
+}
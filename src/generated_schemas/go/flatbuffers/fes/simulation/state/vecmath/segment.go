@@ -0,0 +1,86 @@
+package vecmath
+
+import "math"
+
+// parallelEps is the tolerance, relative to the segment lengths, below which
+// SegmentsIntersect treats two segments as parallel or a parameter as lying
+// on a segment's endpoint. It is scaled so the test does not depend on the
+// world's units.
+const parallelEps = 1e-9
+
+// SegmentsIntersect reports whether segments a1-a2 and b1-b2 meet, and if so
+// returns a point where they do. Endpoints are part of their segment, so
+// segments that only touch at an endpoint intersect there.
+//
+// Segments are parameterised as a1 + t(a2-a1) and b1 + u(b2-b1) and solved
+// with cross products in float64. When the cross product of the directions is
+// within parallelEps of zero, relative to their lengths, the segments are
+// treated as parallel and never cross at a single point. If they are also
+// collinear and overlap, they intersect along a whole stretch; the point
+// returned is then the end of the overlap closest to a1, so a line-of-sight
+// check from a1 finds the first blocking point. A zero-length segment is
+// treated as a point.
+func SegmentsIntersect(a1, a2, b1, b2 Vec2f) (Vec2f, bool) {
+	ax, ay := float64(a1.X), float64(a1.Y)
+	rx, ry := float64(a2.X)-ax, float64(a2.Y)-ay
+	sx, sy := float64(b2.X)-float64(b1.X), float64(b2.Y)-float64(b1.Y)
+	qx, qy := float64(b1.X)-ax, float64(b1.Y)-ay
+
+	rLen, sLen := math.Hypot(rx, ry), math.Hypot(sx, sy)
+	if rLen == 0 || sLen == 0 {
+		return pointSegment(a1, a2, b1, b2, rLen)
+	}
+
+	denom := rx*sy - ry*sx
+	if math.Abs(denom) <= parallelEps*rLen*sLen {
+		// Distance from b1 to the line through a.
+		if math.Abs(qx*ry-qy*rx)/rLen > parallelEps*max(rLen, sLen) {
+			return Vec2f{}, false
+		}
+		// Collinear: intersect the parameter ranges along a.
+		rr := rx*rx + ry*ry
+		t0 := (qx*rx + qy*ry) / rr
+		t1 := t0 + (sx*rx+sy*ry)/rr
+		lo, hi := max(min(t0, t1), 0), min(max(t0, t1), 1)
+		if lo > hi+parallelEps {
+			return Vec2f{}, false
+		}
+		return Vec2f{X: float32(ax + lo*rx), Y: float32(ay + lo*ry)}, true
+	}
+
+	t := (qx*sy - qy*sx) / denom
+	u := (qx*ry - qy*rx) / denom
+	if t < -parallelEps || t > 1+parallelEps || u < -parallelEps || u > 1+parallelEps {
+		return Vec2f{}, false
+	}
+	t = min(max(t, 0), 1)
+	return Vec2f{X: float32(ax + t*rx), Y: float32(ay + t*ry)}, true
+}
+
+// pointSegment handles SegmentsIntersect when at least one segment has zero
+// length. rLen is the length of segment a.
+func pointSegment(a1, a2, b1, b2 Vec2f, rLen float64) (Vec2f, bool) {
+	if rLen == 0 {
+		a1, a2, b1, b2 = b1, b2, a1, a2
+	}
+	// b1 is now the point and a1-a2 the (possibly also zero) segment.
+	if onSegment(b1, a1, a2) {
+		return b1, true
+	}
+	return Vec2f{}, false
+}
+
+func onSegment(p, a, b Vec2f) bool {
+	rx, ry := float64(b.X)-float64(a.X), float64(b.Y)-float64(a.Y)
+	qx, qy := float64(p.X)-float64(a.X), float64(p.Y)-float64(a.Y)
+	rr := rx*rx + ry*ry
+	if rr == 0 {
+		return qx == 0 && qy == 0
+	}
+	rLen := math.Sqrt(rr)
+	if math.Abs(qx*ry-qy*rx)/rLen > parallelEps*rLen {
+		return false
+	}
+	t := (qx*rx + qy*ry) / rr
+	return t >= -parallelEps && t <= 1+parallelEps
+}
@@ -0,0 +1,38 @@
+package vecmath
+
+import "testing"
+
+func TestSegmentsIntersect(t *testing.T) {
+	v := func(x, y float32) Vec2f { return Vec2f{X: x, Y: y} }
+	tests := []struct {
+		name           string
+		a1, a2, b1, b2 Vec2f
+		want           Vec2f
+		ok             bool
+	}{
+		{"crossing", v(0, 0), v(4, 4), v(0, 4), v(4, 0), v(2, 2), true},
+		{"crossing off-centre", v(-1, 0), v(3, 0), v(2, -5), v(2, 1), v(2, 0), true},
+		{"touching at endpoint", v(0, 0), v(2, 2), v(2, 2), v(5, 0), v(2, 2), true},
+		{"T junction", v(0, 0), v(4, 0), v(2, 0), v(2, 3), v(2, 0), true},
+		{"lines cross beyond segments", v(0, 0), v(1, 1), v(3, 0), v(2, 1), Vec2f{}, false},
+		{"parallel", v(0, 0), v(4, 0), v(0, 1), v(4, 1), Vec2f{}, false},
+		{"nearly parallel, apart", v(0, 0), v(1000, 0), v(0, 1), v(1000, 1.0001), Vec2f{}, false},
+		{"collinear overlapping", v(0, 0), v(4, 0), v(2, 0), v(6, 0), v(2, 0), true},
+		{"collinear overlapping reversed", v(0, 0), v(4, 0), v(6, 0), v(-1, 0), v(0, 0), true},
+		{"collinear touching", v(0, 0), v(2, 0), v(2, 0), v(5, 0), v(2, 0), true},
+		{"collinear disjoint", v(0, 0), v(1, 0), v(2, 0), v(3, 0), Vec2f{}, false},
+		{"point on segment", v(1, 1), v(1, 1), v(0, 0), v(2, 2), v(1, 1), true},
+		{"point off segment", v(0, 0), v(2, 2), v(1, 0), v(1, 0), Vec2f{}, false},
+		{"equal points", v(3, 3), v(3, 3), v(3, 3), v(3, 3), v(3, 3), true},
+	}
+	for _, tt := range tests {
+		got, ok := SegmentsIntersect(tt.a1, tt.a2, tt.b1, tt.b2)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("%s: SegmentsIntersect = %v, %v, want %v, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+		// Intersection is symmetric in whether it happens.
+		if _, ok := SegmentsIntersect(tt.b1, tt.b2, tt.a1, tt.a2); ok != tt.ok {
+			t.Errorf("%s: swapped segments report %v", tt.name, ok)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package vecmath
+
+// Transform maps world coordinates to screen pixels for rendering: a point p
+// is scaled by Scale, its Y negated first if FlipY is set, and then offset by
+// Translate.
+//
+// World Y grows upwards but screen Y grows downwards, so a renderer normally
+// sets FlipY; FitBounds does. Scale must be positive for the mapping to be
+// invertible.
+type Transform struct {
+	// Translate is the screen position of the world origin.
+	Translate Vec2f
+	// Scale is the number of pixels per world unit, on both axes.
+	Scale float32
+	// FlipY negates world Y so that it grows up the screen.
+	FlipY bool
+}
+
+// WorldToScreen returns the screen position of world point p.
+func (t Transform) WorldToScreen(p Vec2f) Vec2f {
+	y := float64(p.Y)
+	if t.FlipY {
+		y = -y
+	}
+	return Vec2f{
+		X: float32(float64(p.X)*float64(t.Scale) + float64(t.Translate.X)),
+		Y: float32(y*float64(t.Scale) + float64(t.Translate.Y)),
+	}
+}
+
+// ScreenToWorld returns the world point at screen position s. It is the
+// inverse of WorldToScreen. Both directions compute in float64 and round to
+// float32 once, so a round trip is off only by the rounding of the
+// intermediate float32 value: for world to screen and back, about one
+// float32 ulp of the screen coordinate divided by Scale.
+func (t Transform) ScreenToWorld(s Vec2f) Vec2f {
+	y := (float64(s.Y) - float64(t.Translate.Y)) / float64(t.Scale)
+	if t.FlipY {
+		y = -y
+	}
+	return Vec2f{
+		X: float32((float64(s.X) - float64(t.Translate.X)) / float64(t.Scale)),
+		Y: float32(y),
+	}
+}
+
+// FitBounds returns the FlipY transform that shows all of world on a
+// screenW x screenH pixel screen as large as possible without distortion:
+// the world fills the screen along one axis and is centred along the other,
+// with the world's centre at the screen's centre.
+//
+// A world with zero extent on one axis is fitted by the other alone; one with
+// zero extent on both, such as a single point, is shown at one pixel per world
+// unit. screenW and screenH must be positive.
+func FitBounds(world AABB, screenW, screenH float32) Transform {
+	w := float64(world.Max.X) - float64(world.Min.X)
+	h := float64(world.Max.Y) - float64(world.Min.Y)
+	sx, sy := float64(screenW)/w, float64(screenH)/h
+	var scale float64
+	switch {
+	case w > 0 && h > 0:
+		scale = min(sx, sy)
+	case w > 0:
+		scale = sx
+	case h > 0:
+		scale = sy
+	default:
+		scale = 1
+	}
+
+	cx := (float64(world.Min.X) + float64(world.Max.X)) / 2
+	cy := (float64(world.Min.Y) + float64(world.Max.Y)) / 2
+	return Transform{
+		Translate: Vec2f{
+			X: float32(float64(screenW)/2 - cx*scale),
+			Y: float32(float64(screenH)/2 + cy*scale),
+		},
+		Scale: float32(scale),
+		FlipY: true,
+	}
+}
@@ -0,0 +1,93 @@
+package vecmath
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+const eps32 = 1.0 / (1 << 23)
+
+// roundTripOK reports whether got matches want up to the rounding of the
+// intermediate float32 value mid, scaled by gain on the way back, plus the
+// rounding of want itself.
+func roundTripOK(got, want, mid, gain float32) bool {
+	tol := 2 * eps32 * (math.Abs(float64(mid))*float64(gain) + math.Abs(float64(want)))
+	return math.Abs(float64(got)-float64(want)) <= tol
+}
+
+func TestTransformRoundTrip(t *testing.T) {
+	transforms := []Transform{
+		{Scale: 1},
+		{Translate: Vec2f{X: 400, Y: 300}, Scale: 2.5, FlipY: true},
+		{Translate: Vec2f{X: -12.75, Y: 1e4}, Scale: 0.013, FlipY: false},
+		FitBounds(AABB{Min: Vec2f{X: -500, Y: -20}, Max: Vec2f{X: 1500, Y: 980}}, 1920, 1080),
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, tr := range transforms {
+		for range 1000 {
+			p := Vec2f{X: (rng.Float32() - 0.5) * 4000, Y: (rng.Float32() - 0.5) * 4000}
+			mid := tr.WorldToScreen(p)
+			w := tr.ScreenToWorld(mid)
+			if !roundTripOK(w.X, p.X, mid.X, 1/tr.Scale) || !roundTripOK(w.Y, p.Y, mid.Y, 1/tr.Scale) {
+				t.Fatalf("%+v: world %v -> screen %v -> %v", tr, p, mid, w)
+			}
+			mid = tr.ScreenToWorld(p)
+			s := tr.WorldToScreen(mid)
+			if !roundTripOK(s.X, p.X, mid.X, tr.Scale) || !roundTripOK(s.Y, p.Y, mid.Y, tr.Scale) {
+				t.Fatalf("%+v: screen %v -> world %v -> %v", tr, p, mid, s)
+			}
+		}
+	}
+}
+
+func TestTransformFlipY(t *testing.T) {
+	tr := Transform{Translate: Vec2f{X: 10, Y: 100}, Scale: 2, FlipY: true}
+	if got := tr.WorldToScreen(Vec2f{X: 1, Y: 5}); got != (Vec2f{X: 12, Y: 90}) {
+		t.Errorf("WorldToScreen = %v, want {12 90}: world up must be screen up", got)
+	}
+	tr.FlipY = false
+	if got := tr.WorldToScreen(Vec2f{X: 1, Y: 5}); got != (Vec2f{X: 12, Y: 110}) {
+		t.Errorf("WorldToScreen without flip = %v, want {12 110}", got)
+	}
+}
+
+func TestFitBounds(t *testing.T) {
+	tests := []struct {
+		name             string
+		world            AABB
+		screenW, screenH float32
+		scale            float32
+	}{
+		// A square world on a wide screen is height-limited, with margins
+		// left and right.
+		{"square on wide", AABB{Max: Vec2f{X: 100, Y: 100}}, 1600, 900, 9},
+		// A wide world on a tall screen is width-limited, with margins above
+		// and below.
+		{"wide on tall", AABB{Min: Vec2f{X: -50, Y: 10}, Max: Vec2f{X: 150, Y: 60}}, 400, 1000, 2},
+		{"flat line", AABB{Min: Vec2f{X: 0, Y: 5}, Max: Vec2f{X: 10, Y: 5}}, 200, 100, 20},
+		{"point", AABB{Min: Vec2f{X: 3, Y: 3}, Max: Vec2f{X: 3, Y: 3}}, 200, 100, 1},
+	}
+	for _, tt := range tests {
+		tr := FitBounds(tt.world, tt.screenW, tt.screenH)
+		if tr.Scale != tt.scale || !tr.FlipY {
+			t.Errorf("%s: scale %v flip %v, want %v and true", tt.name, tr.Scale, tr.FlipY, tt.scale)
+		}
+
+		centre := Vec2f{X: (tt.world.Min.X + tt.world.Max.X) / 2, Y: (tt.world.Min.Y + tt.world.Max.Y) / 2}
+		if got := tr.WorldToScreen(centre); got != (Vec2f{X: tt.screenW / 2, Y: tt.screenH / 2}) {
+			t.Errorf("%s: world centre maps to %v, want screen centre", tt.name, got)
+		}
+
+		// Min maps to the bottom-left corner and Max to the top-right, both
+		// on screen, with equal margins on each side of the slack axis.
+		lo := tr.WorldToScreen(tt.world.Min)
+		hi := tr.WorldToScreen(tt.world.Max)
+		if lo.X < 0 || hi.X > tt.screenW || hi.Y < 0 || lo.Y > tt.screenH {
+			t.Errorf("%s: world spans screen %v to %v, outside %vx%v", tt.name, lo, hi, tt.screenW, tt.screenH)
+		}
+		if lo.X != tt.screenW-hi.X || hi.Y != tt.screenH-lo.Y {
+			t.Errorf("%s: margins uneven: %v to %v on %vx%v", tt.name, lo, hi, tt.screenW, tt.screenH)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Package vecmath provides plain-Go vector arithmetic for simulation code.
+//
+// The generated state.Vec2f is a FlatBuffers accessor into a byte buffer and
+// has no arithmetic of its own. Vec2f here is a lightweight value type; use
+// FromFB and ToFB to move between the two without touching generated code.
+package vecmath
+
+import (
+	"math"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+// Vec2f is a 2D float32 vector held by value.
+type Vec2f struct {
+	X, Y float32
+}
+
+// Add returns a + b.
+func Add(a, b Vec2f) Vec2f {
+	return Vec2f{X: a.X + b.X, Y: a.Y + b.Y}
+}
+
+// Sub returns a - b.
+func Sub(a, b Vec2f) Vec2f {
+	return Vec2f{X: a.X - b.X, Y: a.Y - b.Y}
+}
+
+// Scale returns v multiplied by s.
+func Scale(v Vec2f, s float32) Vec2f {
+	return Vec2f{X: v.X * s, Y: v.Y * s}
+}
+
+// Dot returns the dot product of a and b.
+func Dot(a, b Vec2f) float32 {
+	return a.X*b.X + a.Y*b.Y
+}
+
+// LengthSq returns the squared length of v. Prefer it over Length for
+// comparisons, since it avoids the square root.
+func LengthSq(v Vec2f) float32 {
+	return Dot(v, v)
+}
+
+// Length returns the Euclidean length of v.
+//
+// The computation is done in float64 so that components near the float32
+// limit do not overflow to +Inf when squared.
+func Length(v Vec2f) float32 {
+	return float32(math.Hypot(float64(v.X), float64(v.Y)))
+}
+
+// Normalize returns v scaled to unit length.
+//
+// A zero-length input returns the zero vector rather than NaN, so callers can
+// normalize a velocity without first checking whether the agent is moving.
+func Normalize(v Vec2f) Vec2f {
+	l := math.Hypot(float64(v.X), float64(v.Y))
+	if l == 0 {
+		return Vec2f{}
+	}
+	return Vec2f{X: float32(float64(v.X) / l), Y: float32(float64(v.Y) / l)}
+}
+
+// Distance returns the Euclidean distance between a and b.
+func Distance(a, b Vec2f) float32 {
+	return float32(math.Hypot(float64(a.X)-float64(b.X), float64(a.Y)-float64(b.Y)))
+}
+
+// IsFinite reports whether neither component of v is NaN or ±Inf.
+func (v Vec2f) IsFinite() bool {
+	// x-x is 0 for every finite x and NaN for NaN and both infinities.
+	return v.X-v.X == 0 && v.Y-v.Y == 0
+}
+
+// FromFB copies the components of a FlatBuffers Vec2f into a value. A nil
+// accessor, as returned for an absent struct field, yields the zero vector.
+func FromFB(v *state.Vec2f) Vec2f {
+	if v == nil {
+		return Vec2f{}
+	}
+	return Vec2f{X: v.X(), Y: v.Y()}
+}
+
+// ToFB writes v into builder as an inline FlatBuffers Vec2f struct and
+// returns its offset, for use with the generated Add<Field> helpers.
+func ToFB(builder *flatbuffers.Builder, v Vec2f) flatbuffers.UOffsetT {
+	return state.CreateVec2f(builder, v.X, v.Y)
+}
+
+// Lerp linearly interpolates from a (t = 0) to b (t = 1). Both endpoints are
+// returned exactly. t is not clamped: values outside [0, 1] extrapolate along
+// the line through a and b.
+func Lerp(a, b Vec2f, t float32) Vec2f {
+	return Vec2f{X: a.X*(1-t) + b.X*t, Y: a.Y*(1-t) + b.Y*t}
+}
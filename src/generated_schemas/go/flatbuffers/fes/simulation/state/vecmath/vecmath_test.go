@@ -0,0 +1,130 @@
+package vecmath
+
+import (
+	"math"
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"fish_eco_sim/src/generated_schemas/go/flatbuffers/fes/simulation/state"
+)
+
+func TestArithmetic(t *testing.T) {
+	a := Vec2f{X: 1, Y: 2}
+	b := Vec2f{X: 3, Y: -4}
+
+	if got := Add(a, b); got != (Vec2f{X: 4, Y: -2}) {
+		t.Errorf("Add = %v", got)
+	}
+	if got := Sub(a, b); got != (Vec2f{X: -2, Y: 6}) {
+		t.Errorf("Sub = %v", got)
+	}
+	if got := Scale(a, -2); got != (Vec2f{X: -2, Y: -4}) {
+		t.Errorf("Scale = %v", got)
+	}
+	if got := Dot(a, b); got != -5 {
+		t.Errorf("Dot = %v", got)
+	}
+	if got := LengthSq(b); got != 25 {
+		t.Errorf("LengthSq = %v", got)
+	}
+	if got := Length(b); got != 5 {
+		t.Errorf("Length = %v", got)
+	}
+	if got := Distance(Vec2f{}, b); got != 5 {
+		t.Errorf("Distance = %v", got)
+	}
+}
+
+func TestNormalizeZeroLength(t *testing.T) {
+	got := Normalize(Vec2f{})
+	if got != (Vec2f{}) {
+		t.Fatalf("Normalize(zero) = %v, want zero vector", got)
+	}
+}
+
+func TestNormalizeUnit(t *testing.T) {
+	got := Normalize(Vec2f{X: 3, Y: 4})
+	if math.Abs(float64(got.X)-0.6) > 1e-6 || math.Abs(float64(got.Y)-0.8) > 1e-6 {
+		t.Fatalf("Normalize = %v, want {0.6 0.8}", got)
+	}
+}
+
+func TestLargeMagnitude(t *testing.T) {
+	big := Vec2f{X: 2e38, Y: 2e38}
+
+	l := Length(big)
+	if math.IsInf(float64(l), 0) || math.IsNaN(float64(l)) {
+		t.Fatalf("Length overflowed: %v", l)
+	}
+
+	n := Normalize(big)
+	want := float32(1 / math.Sqrt2)
+	if math.Abs(float64(n.X-want)) > 1e-6 || math.Abs(float64(n.Y-want)) > 1e-6 {
+		t.Fatalf("Normalize(big) = %v, want {%v %v}", n, want, want)
+	}
+
+	d := Distance(Vec2f{X: -3e38}, Vec2f{X: 3e38})
+	if !math.IsInf(float64(d), 1) {
+		// 6e38 is not representable in float32; the overflow must be a
+		// clean +Inf rather than NaN.
+		t.Fatalf("Distance = %v, want +Inf", d)
+	}
+}
+
+func TestFBRoundTrip(t *testing.T) {
+	b := flatbuffers.NewBuilder(0)
+	id := b.CreateString("fish-1")
+	state.AgentStateStart(b)
+	state.AgentStateAddId(b, id)
+	state.AgentStateAddPos(b, ToFB(b, Vec2f{X: 1.5, Y: -2}))
+	b.Finish(state.AgentStateEnd(b))
+
+	agent := state.GetRootAsAgentState(b.FinishedBytes(), 0)
+	if got := FromFB(agent.Pos(nil)); got != (Vec2f{X: 1.5, Y: -2}) {
+		t.Fatalf("FromFB = %v", got)
+	}
+	if got := FromFB(nil); got != (Vec2f{}) {
+		t.Fatalf("FromFB(nil) = %v", got)
+	}
+}
+
+func TestLerp(t *testing.T) {
+	a := Vec2f{X: 0.1, Y: -3}
+	b := Vec2f{X: 7.3, Y: 5}
+	if got := Lerp(a, b, 0); got != a {
+		t.Errorf("Lerp(t=0) = %v, want %v", got, a)
+	}
+	if got := Lerp(a, b, 1); got != b {
+		t.Errorf("Lerp(t=1) = %v, want %v", got, b)
+	}
+	if got := Lerp(Vec2f{}, Vec2f{X: 10, Y: -10}, 0.25); got != (Vec2f{X: 2.5, Y: -2.5}) {
+		t.Errorf("Lerp(t=0.25) = %v", got)
+	}
+	if got := Lerp(Vec2f{}, Vec2f{X: 10}, 2); got != (Vec2f{X: 20}) {
+		t.Errorf("Lerp(t=2) = %v, want extrapolation to {20 0}", got)
+	}
+}
+
+func TestIsFinite(t *testing.T) {
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(1))
+	tests := []struct {
+		v    Vec2f
+		want bool
+	}{
+		{Vec2f{}, true},
+		{Vec2f{X: -3, Y: 1e-40}, true},
+		{Vec2f{X: math.MaxFloat32, Y: -math.MaxFloat32}, true},
+		{Vec2f{X: nan}, false},
+		{Vec2f{Y: nan}, false},
+		{Vec2f{X: inf}, false},
+		{Vec2f{Y: -inf}, false},
+		{Vec2f{X: nan, Y: inf}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.v.IsFinite(); got != tt.want {
+			t.Errorf("%v.IsFinite() = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
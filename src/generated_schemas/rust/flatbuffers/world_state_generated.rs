@@ -1,416 +1,1984 @@
 // automatically generated by the FlatBuffers compiler, do not modify
-
-
 // @generated
+extern crate alloc;
 
-use core::mem;
-use core::cmp::Ordering;
-
-extern crate flatbuffers;
-use self::flatbuffers::{EndianScalar, Follow};
 
 #[allow(unused_imports, dead_code)]
 pub mod fes {
 
-  use core::mem;
-  use core::cmp::Ordering;
-
-  extern crate flatbuffers;
-  use self::flatbuffers::{EndianScalar, Follow};
 #[allow(unused_imports, dead_code)]
 pub mod simulation {
 
-  use core::mem;
-  use core::cmp::Ordering;
+#[allow(unused_imports, dead_code)]
+pub mod state {
+
+
+#[deprecated(since = "2.0.0", note = "Use associated constants instead. This will no longer be generated in 2021.")]
+pub const ENUM_MIN_SPECIES: i8 = 0;
+#[deprecated(since = "2.0.0", note = "Use associated constants instead. This will no longer be generated in 2021.")]
+pub const ENUM_MAX_SPECIES: i8 = 4;
+#[deprecated(since = "2.0.0", note = "Use associated constants instead. This will no longer be generated in 2021.")]
+#[allow(non_camel_case_types)]
+pub const ENUM_VALUES_SPECIES: [Species; 5] = [
+  Species::Unknown,
+  Species::Guppy,
+  Species::Pike,
+  Species::Angelfish,
+  Species::Catfish,
+];
+
+#[derive(Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash, Default)]
+#[repr(transparent)]
+pub struct Species(pub i8);
+#[allow(non_upper_case_globals)]
+impl Species {
+  pub const Unknown: Self = Self(0);
+  pub const Guppy: Self = Self(1);
+  pub const Pike: Self = Self(2);
+  pub const Angelfish: Self = Self(3);
+  pub const Catfish: Self = Self(4);
+
+  pub const ENUM_MIN: i8 = 0;
+  pub const ENUM_MAX: i8 = 4;
+  pub const ENUM_VALUES: &'static [Self] = &[
+    Self::Unknown,
+    Self::Guppy,
+    Self::Pike,
+    Self::Angelfish,
+    Self::Catfish,
+  ];
+  /// Returns the variant's name or "" if unknown.
+  pub fn variant_name(self) -> Option<&'static str> {
+    match self {
+      Self::Unknown => Some("Unknown"),
+      Self::Guppy => Some("Guppy"),
+      Self::Pike => Some("Pike"),
+      Self::Angelfish => Some("Angelfish"),
+      Self::Catfish => Some("Catfish"),
+      _ => None,
+    }
+  }
+}
+impl ::core::fmt::Debug for Species {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter) -> ::core::fmt::Result {
+    if let Some(name) = self.variant_name() {
+      f.write_str(name)
+    } else {
+      f.write_fmt(format_args!("<UNKNOWN {:?}>", self.0))
+    }
+  }
+}
+impl<'a> ::flatbuffers::Follow<'a> for Species {
+  type Inner = Self;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    let b = unsafe { ::flatbuffers::read_scalar_at::<i8>(buf, loc) };
+    Self(b)
+  }
+}
+
+impl ::flatbuffers::Push for Species {
+    type Output = Species;
+    #[inline]
+    unsafe fn push(&self, dst: &mut [u8], _written_len: usize) {
+        unsafe { ::flatbuffers::emplace_scalar::<i8>(dst, self.0) };
+    }
+}
+
+impl ::flatbuffers::EndianScalar for Species {
+  type Scalar = i8;
+  #[inline]
+  fn to_little_endian(self) -> i8 {
+    self.0.to_le()
+  }
+  #[inline]
+  #[allow(clippy::wrong_self_convention)]
+  fn from_little_endian(v: i8) -> Self {
+    let b = i8::from_le(v);
+    Self(b)
+  }
+}
+
+impl<'a> ::flatbuffers::Verifiable for Species {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    i8::run_verifier(v, pos)
+  }
+}
+
+impl ::flatbuffers::SimpleToVerifyInSlice for Species {}
+#[deprecated(since = "2.0.0", note = "Use associated constants instead. This will no longer be generated in 2021.")]
+pub const ENUM_MIN_ENTITY: u8 = 0;
+#[deprecated(since = "2.0.0", note = "Use associated constants instead. This will no longer be generated in 2021.")]
+pub const ENUM_MAX_ENTITY: u8 = 3;
+#[deprecated(since = "2.0.0", note = "Use associated constants instead. This will no longer be generated in 2021.")]
+#[allow(non_camel_case_types)]
+pub const ENUM_VALUES_ENTITY: [Entity; 4] = [
+  Entity::NONE,
+  Entity::FishState,
+  Entity::FoodState,
+  Entity::PlantState,
+];
+
+#[derive(Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Hash, Default)]
+#[repr(transparent)]
+pub struct Entity(pub u8);
+#[allow(non_upper_case_globals)]
+impl Entity {
+  pub const NONE: Self = Self(0);
+  pub const FishState: Self = Self(1);
+  pub const FoodState: Self = Self(2);
+  pub const PlantState: Self = Self(3);
+
+  pub const ENUM_MIN: u8 = 0;
+  pub const ENUM_MAX: u8 = 3;
+  pub const ENUM_VALUES: &'static [Self] = &[
+    Self::NONE,
+    Self::FishState,
+    Self::FoodState,
+    Self::PlantState,
+  ];
+  /// Returns the variant's name or "" if unknown.
+  pub fn variant_name(self) -> Option<&'static str> {
+    match self {
+      Self::NONE => Some("NONE"),
+      Self::FishState => Some("FishState"),
+      Self::FoodState => Some("FoodState"),
+      Self::PlantState => Some("PlantState"),
+      _ => None,
+    }
+  }
+}
+impl ::core::fmt::Debug for Entity {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter) -> ::core::fmt::Result {
+    if let Some(name) = self.variant_name() {
+      f.write_str(name)
+    } else {
+      f.write_fmt(format_args!("<UNKNOWN {:?}>", self.0))
+    }
+  }
+}
+impl<'a> ::flatbuffers::Follow<'a> for Entity {
+  type Inner = Self;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    let b = unsafe { ::flatbuffers::read_scalar_at::<u8>(buf, loc) };
+    Self(b)
+  }
+}
+
+impl ::flatbuffers::Push for Entity {
+    type Output = Entity;
+    #[inline]
+    unsafe fn push(&self, dst: &mut [u8], _written_len: usize) {
+        unsafe { ::flatbuffers::emplace_scalar::<u8>(dst, self.0) };
+    }
+}
+
+impl ::flatbuffers::EndianScalar for Entity {
+  type Scalar = u8;
+  #[inline]
+  fn to_little_endian(self) -> u8 {
+    self.0.to_le()
+  }
+  #[inline]
+  #[allow(clippy::wrong_self_convention)]
+  fn from_little_endian(v: u8) -> Self {
+    let b = u8::from_le(v);
+    Self(b)
+  }
+}
+
+impl<'a> ::flatbuffers::Verifiable for Entity {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    u8::run_verifier(v, pos)
+  }
+}
+
+impl ::flatbuffers::SimpleToVerifyInSlice for Entity {}
+pub struct EntityUnionTableOffset {}
+
+// struct Vec2f, aligned to 4
+#[repr(transparent)]
+#[derive(Clone, Copy, PartialEq)]
+pub struct Vec2f(pub [u8; 8]);
+impl Default for Vec2f { 
+  fn default() -> Self { 
+    Self([0; 8])
+  }
+}
+impl ::core::fmt::Debug for Vec2f {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter) -> ::core::fmt::Result {
+    f.debug_struct("Vec2f")
+      .field("x", &self.x())
+      .field("y", &self.y())
+      .finish()
+  }
+}
+
+impl ::flatbuffers::SimpleToVerifyInSlice for Vec2f {}
+impl<'a> ::flatbuffers::Follow<'a> for Vec2f {
+  type Inner = &'a Vec2f;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { <&'a Vec2f>::follow(buf, loc) }
+  }
+}
+impl<'a> ::flatbuffers::Follow<'a> for &'a Vec2f {
+  type Inner = &'a Vec2f;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { ::flatbuffers::follow_cast_ref::<Vec2f>(buf, loc) }
+  }
+}
+impl<'b> ::flatbuffers::Push for Vec2f {
+    type Output = Vec2f;
+    #[inline]
+    unsafe fn push(&self, dst: &mut [u8], _written_len: usize) {
+        let src = unsafe { ::core::slice::from_raw_parts(self as *const Vec2f as *const u8, <Self as ::flatbuffers::Push>::size()) };
+        dst.copy_from_slice(src);
+    }
+    #[inline]
+    fn alignment() -> ::flatbuffers::PushAlignment {
+        ::flatbuffers::PushAlignment::new(4)
+    }
+}
+
+impl<'a> ::flatbuffers::Verifiable for Vec2f {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.in_buffer::<Self>(pos)
+  }
+}
+
+impl<'a> Vec2f {
+  #[allow(clippy::too_many_arguments)]
+  pub fn new(
+    x: f32,
+    y: f32,
+  ) -> Self {
+    let mut s = Self([0; 8]);
+    s.set_x(x);
+    s.set_y(y);
+    s
+  }
+
+  pub fn x(&self) -> f32 {
+    let mut mem = ::core::mem::MaybeUninit::<<f32 as ::flatbuffers::EndianScalar>::Scalar>::uninit();
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    ::flatbuffers::EndianScalar::from_little_endian(unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        self.0[0..].as_ptr(),
+        mem.as_mut_ptr() as *mut u8,
+        ::core::mem::size_of::<<f32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+      mem.assume_init()
+    })
+  }
+
+  pub fn set_x(&mut self, x: f32) {
+    let x_le = ::flatbuffers::EndianScalar::to_little_endian(x);
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        &x_le as *const _ as *const u8,
+        self.0[0..].as_mut_ptr(),
+        ::core::mem::size_of::<<f32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+    }
+  }
+
+  pub fn y(&self) -> f32 {
+    let mut mem = ::core::mem::MaybeUninit::<<f32 as ::flatbuffers::EndianScalar>::Scalar>::uninit();
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    ::flatbuffers::EndianScalar::from_little_endian(unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        self.0[4..].as_ptr(),
+        mem.as_mut_ptr() as *mut u8,
+        ::core::mem::size_of::<<f32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+      mem.assume_init()
+    })
+  }
+
+  pub fn set_y(&mut self, x: f32) {
+    let x_le = ::flatbuffers::EndianScalar::to_little_endian(x);
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        &x_le as *const _ as *const u8,
+        self.0[4..].as_mut_ptr(),
+        ::core::mem::size_of::<<f32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+    }
+  }
+
+}
+
+// struct Vec2i, aligned to 4
+#[repr(transparent)]
+#[derive(Clone, Copy, PartialEq)]
+pub struct Vec2i(pub [u8; 8]);
+impl Default for Vec2i { 
+  fn default() -> Self { 
+    Self([0; 8])
+  }
+}
+impl ::core::fmt::Debug for Vec2i {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter) -> ::core::fmt::Result {
+    f.debug_struct("Vec2i")
+      .field("x", &self.x())
+      .field("y", &self.y())
+      .finish()
+  }
+}
+
+impl ::flatbuffers::SimpleToVerifyInSlice for Vec2i {}
+impl<'a> ::flatbuffers::Follow<'a> for Vec2i {
+  type Inner = &'a Vec2i;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { <&'a Vec2i>::follow(buf, loc) }
+  }
+}
+impl<'a> ::flatbuffers::Follow<'a> for &'a Vec2i {
+  type Inner = &'a Vec2i;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { ::flatbuffers::follow_cast_ref::<Vec2i>(buf, loc) }
+  }
+}
+impl<'b> ::flatbuffers::Push for Vec2i {
+    type Output = Vec2i;
+    #[inline]
+    unsafe fn push(&self, dst: &mut [u8], _written_len: usize) {
+        let src = unsafe { ::core::slice::from_raw_parts(self as *const Vec2i as *const u8, <Self as ::flatbuffers::Push>::size()) };
+        dst.copy_from_slice(src);
+    }
+    #[inline]
+    fn alignment() -> ::flatbuffers::PushAlignment {
+        ::flatbuffers::PushAlignment::new(4)
+    }
+}
+
+impl<'a> ::flatbuffers::Verifiable for Vec2i {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.in_buffer::<Self>(pos)
+  }
+}
+
+impl<'a> Vec2i {
+  #[allow(clippy::too_many_arguments)]
+  pub fn new(
+    x: i32,
+    y: i32,
+  ) -> Self {
+    let mut s = Self([0; 8]);
+    s.set_x(x);
+    s.set_y(y);
+    s
+  }
+
+  pub fn x(&self) -> i32 {
+    let mut mem = ::core::mem::MaybeUninit::<<i32 as ::flatbuffers::EndianScalar>::Scalar>::uninit();
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    ::flatbuffers::EndianScalar::from_little_endian(unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        self.0[0..].as_ptr(),
+        mem.as_mut_ptr() as *mut u8,
+        ::core::mem::size_of::<<i32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+      mem.assume_init()
+    })
+  }
+
+  pub fn set_x(&mut self, x: i32) {
+    let x_le = ::flatbuffers::EndianScalar::to_little_endian(x);
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        &x_le as *const _ as *const u8,
+        self.0[0..].as_mut_ptr(),
+        ::core::mem::size_of::<<i32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+    }
+  }
+
+  pub fn y(&self) -> i32 {
+    let mut mem = ::core::mem::MaybeUninit::<<i32 as ::flatbuffers::EndianScalar>::Scalar>::uninit();
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    ::flatbuffers::EndianScalar::from_little_endian(unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        self.0[4..].as_ptr(),
+        mem.as_mut_ptr() as *mut u8,
+        ::core::mem::size_of::<<i32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+      mem.assume_init()
+    })
+  }
+
+  pub fn set_y(&mut self, x: i32) {
+    let x_le = ::flatbuffers::EndianScalar::to_little_endian(x);
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        &x_le as *const _ as *const u8,
+        self.0[4..].as_mut_ptr(),
+        ::core::mem::size_of::<<i32 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+    }
+  }
+
+}
+
+// struct Vec2s, aligned to 2
+#[repr(transparent)]
+#[derive(Clone, Copy, PartialEq)]
+pub struct Vec2s(pub [u8; 4]);
+impl Default for Vec2s { 
+  fn default() -> Self { 
+    Self([0; 4])
+  }
+}
+impl ::core::fmt::Debug for Vec2s {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter) -> ::core::fmt::Result {
+    f.debug_struct("Vec2s")
+      .field("x", &self.x())
+      .field("y", &self.y())
+      .finish()
+  }
+}
+
+impl ::flatbuffers::SimpleToVerifyInSlice for Vec2s {}
+impl<'a> ::flatbuffers::Follow<'a> for Vec2s {
+  type Inner = &'a Vec2s;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { <&'a Vec2s>::follow(buf, loc) }
+  }
+}
+impl<'a> ::flatbuffers::Follow<'a> for &'a Vec2s {
+  type Inner = &'a Vec2s;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { ::flatbuffers::follow_cast_ref::<Vec2s>(buf, loc) }
+  }
+}
+impl<'b> ::flatbuffers::Push for Vec2s {
+    type Output = Vec2s;
+    #[inline]
+    unsafe fn push(&self, dst: &mut [u8], _written_len: usize) {
+        let src = unsafe { ::core::slice::from_raw_parts(self as *const Vec2s as *const u8, <Self as ::flatbuffers::Push>::size()) };
+        dst.copy_from_slice(src);
+    }
+    #[inline]
+    fn alignment() -> ::flatbuffers::PushAlignment {
+        ::flatbuffers::PushAlignment::new(2)
+    }
+}
+
+impl<'a> ::flatbuffers::Verifiable for Vec2s {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.in_buffer::<Self>(pos)
+  }
+}
+
+impl<'a> Vec2s {
+  #[allow(clippy::too_many_arguments)]
+  pub fn new(
+    x: i16,
+    y: i16,
+  ) -> Self {
+    let mut s = Self([0; 4]);
+    s.set_x(x);
+    s.set_y(y);
+    s
+  }
+
+  pub fn x(&self) -> i16 {
+    let mut mem = ::core::mem::MaybeUninit::<<i16 as ::flatbuffers::EndianScalar>::Scalar>::uninit();
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    ::flatbuffers::EndianScalar::from_little_endian(unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        self.0[0..].as_ptr(),
+        mem.as_mut_ptr() as *mut u8,
+        ::core::mem::size_of::<<i16 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+      mem.assume_init()
+    })
+  }
+
+  pub fn set_x(&mut self, x: i16) {
+    let x_le = ::flatbuffers::EndianScalar::to_little_endian(x);
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        &x_le as *const _ as *const u8,
+        self.0[0..].as_mut_ptr(),
+        ::core::mem::size_of::<<i16 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+    }
+  }
+
+  pub fn y(&self) -> i16 {
+    let mut mem = ::core::mem::MaybeUninit::<<i16 as ::flatbuffers::EndianScalar>::Scalar>::uninit();
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    ::flatbuffers::EndianScalar::from_little_endian(unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        self.0[2..].as_ptr(),
+        mem.as_mut_ptr() as *mut u8,
+        ::core::mem::size_of::<<i16 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+      mem.assume_init()
+    })
+  }
+
+  pub fn set_y(&mut self, x: i16) {
+    let x_le = ::flatbuffers::EndianScalar::to_little_endian(x);
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid value in this slot
+    unsafe {
+      ::core::ptr::copy_nonoverlapping(
+        &x_le as *const _ as *const u8,
+        self.0[2..].as_mut_ptr(),
+        ::core::mem::size_of::<<i16 as ::flatbuffers::EndianScalar>::Scalar>(),
+      );
+    }
+  }
+
+}
+
+// struct AABB, aligned to 4
+#[repr(transparent)]
+#[derive(Clone, Copy, PartialEq)]
+pub struct AABB(pub [u8; 16]);
+impl Default for AABB { 
+  fn default() -> Self { 
+    Self([0; 16])
+  }
+}
+impl ::core::fmt::Debug for AABB {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter) -> ::core::fmt::Result {
+    f.debug_struct("AABB")
+      .field("min", &self.min())
+      .field("max", &self.max())
+      .finish()
+  }
+}
+
+impl ::flatbuffers::SimpleToVerifyInSlice for AABB {}
+impl<'a> ::flatbuffers::Follow<'a> for AABB {
+  type Inner = &'a AABB;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { <&'a AABB>::follow(buf, loc) }
+  }
+}
+impl<'a> ::flatbuffers::Follow<'a> for &'a AABB {
+  type Inner = &'a AABB;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    unsafe { ::flatbuffers::follow_cast_ref::<AABB>(buf, loc) }
+  }
+}
+impl<'b> ::flatbuffers::Push for AABB {
+    type Output = AABB;
+    #[inline]
+    unsafe fn push(&self, dst: &mut [u8], _written_len: usize) {
+        let src = unsafe { ::core::slice::from_raw_parts(self as *const AABB as *const u8, <Self as ::flatbuffers::Push>::size()) };
+        dst.copy_from_slice(src);
+    }
+    #[inline]
+    fn alignment() -> ::flatbuffers::PushAlignment {
+        ::flatbuffers::PushAlignment::new(4)
+    }
+}
+
+impl<'a> ::flatbuffers::Verifiable for AABB {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.in_buffer::<Self>(pos)
+  }
+}
+
+impl<'a> AABB {
+  #[allow(clippy::too_many_arguments)]
+  pub fn new(
+    min: &Vec2f,
+    max: &Vec2f,
+  ) -> Self {
+    let mut s = Self([0; 16]);
+    s.set_min(min);
+    s.set_max(max);
+    s
+  }
+
+  pub fn min(&self) -> &Vec2f {
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid struct in this slot
+    unsafe { &*(self.0[0..].as_ptr() as *const Vec2f) }
+  }
+
+  #[allow(clippy::identity_op)]
+  pub fn set_min(&mut self, x: &Vec2f) {
+    self.0[0..0 + 8].copy_from_slice(&x.0)
+  }
+
+  pub fn max(&self) -> &Vec2f {
+    // Safety:
+    // Created from a valid Table for this object
+    // Which contains a valid struct in this slot
+    unsafe { &*(self.0[8..].as_ptr() as *const Vec2f) }
+  }
+
+  #[allow(clippy::identity_op)]
+  pub fn set_max(&mut self, x: &Vec2f) {
+    self.0[8..8 + 8].copy_from_slice(&x.0)
+  }
+
+}
+
+pub enum AgentStateOffset {}
+#[derive(Copy, Clone, PartialEq)]
+
+pub struct AgentState<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
+}
+
+impl<'a> ::flatbuffers::Follow<'a> for AgentState<'a> {
+  type Inner = AgentState<'a>;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
+  }
+}
+
+impl<'a> AgentState<'a> {
+  pub const VT_ID: ::flatbuffers::VOffsetT = 4;
+  pub const VT_POS: ::flatbuffers::VOffsetT = 6;
+
+  #[inline]
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    AgentState { _tab: table }
+  }
+  #[allow(unused_mut)]
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args AgentStateArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<AgentState<'bldr>> {
+    let mut builder = AgentStateBuilder::new(_fbb);
+    if let Some(x) = args.pos { builder.add_pos(x); }
+    if let Some(x) = args.id { builder.add_id(x); }
+    builder.finish()
+  }
+
+
+  #[inline]
+  pub fn id(&self) -> &'a str {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<&str>>(AgentState::VT_ID, None).unwrap()}
+  }
+  #[inline]
+  pub fn key_compare_less_than(&self, o: &AgentState) -> bool {
+    self.id() < o.id()
+  }
+
+  #[inline]
+  pub fn key_compare_with_value(&self, val: & str) -> ::core::cmp::Ordering {
+    let key = self.id();
+    key.cmp(val)
+  }
+  #[inline]
+  pub fn pos(&self) -> &'a Vec2f {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Vec2f>(AgentState::VT_POS, None).unwrap()}
+  }
+}
+
+impl ::flatbuffers::Verifiable for AgentState<'_> {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.visit_table(pos)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<&str>>("id", Self::VT_ID, true)?
+     .visit_field::<Vec2f>("pos", Self::VT_POS, true)?
+     .finish();
+    Ok(())
+  }
+}
+pub struct AgentStateArgs<'a> {
+    pub id: Option<::flatbuffers::WIPOffset<&'a str>>,
+    pub pos: Option<&'a Vec2f>,
+}
+impl<'a> Default for AgentStateArgs<'a> {
+  #[inline]
+  fn default() -> Self {
+    AgentStateArgs {
+      id: None, // required field
+      pos: None, // required field
+    }
+  }
+}
+
+pub struct AgentStateBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
+}
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> AgentStateBuilder<'a, 'b, A> {
+  #[inline]
+  pub fn add_id(&mut self, id: ::flatbuffers::WIPOffset<&'b  str>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(AgentState::VT_ID, id);
+  }
+  #[inline]
+  pub fn add_pos(&mut self, pos: &Vec2f) {
+    self.fbb_.push_slot_always::<&Vec2f>(AgentState::VT_POS, pos);
+  }
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> AgentStateBuilder<'a, 'b, A> {
+    let start = _fbb.start_table();
+    AgentStateBuilder {
+      fbb_: _fbb,
+      start_: start,
+    }
+  }
+  #[inline]
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<AgentState<'a>> {
+    let o = self.fbb_.end_table(self.start_);
+    self.fbb_.required(o, AgentState::VT_ID,"id");
+    self.fbb_.required(o, AgentState::VT_POS,"pos");
+    ::flatbuffers::WIPOffset::new(o.value())
+  }
+}
+
+impl ::core::fmt::Debug for AgentState<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("AgentState");
+      ds.field("id", &self.id());
+      ds.field("pos", &self.pos());
+      ds.finish()
+  }
+}
+pub enum FoodPelletStateOffset {}
+#[derive(Copy, Clone, PartialEq)]
+
+pub struct FoodPelletState<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
+}
+
+impl<'a> ::flatbuffers::Follow<'a> for FoodPelletState<'a> {
+  type Inner = FoodPelletState<'a>;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
+  }
+}
+
+impl<'a> FoodPelletState<'a> {
+  pub const VT_ID: ::flatbuffers::VOffsetT = 4;
+  pub const VT_POS: ::flatbuffers::VOffsetT = 6;
+
+  #[inline]
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    FoodPelletState { _tab: table }
+  }
+  #[allow(unused_mut)]
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args FoodPelletStateArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<FoodPelletState<'bldr>> {
+    let mut builder = FoodPelletStateBuilder::new(_fbb);
+    if let Some(x) = args.pos { builder.add_pos(x); }
+    if let Some(x) = args.id { builder.add_id(x); }
+    builder.finish()
+  }
+
+
+  #[inline]
+  pub fn id(&self) -> &'a str {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<&str>>(FoodPelletState::VT_ID, None).unwrap()}
+  }
+  #[inline]
+  pub fn key_compare_less_than(&self, o: &FoodPelletState) -> bool {
+    self.id() < o.id()
+  }
+
+  #[inline]
+  pub fn key_compare_with_value(&self, val: & str) -> ::core::cmp::Ordering {
+    let key = self.id();
+    key.cmp(val)
+  }
+  #[inline]
+  pub fn pos(&self) -> &'a Vec2f {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Vec2f>(FoodPelletState::VT_POS, None).unwrap()}
+  }
+}
+
+impl ::flatbuffers::Verifiable for FoodPelletState<'_> {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.visit_table(pos)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<&str>>("id", Self::VT_ID, true)?
+     .visit_field::<Vec2f>("pos", Self::VT_POS, true)?
+     .finish();
+    Ok(())
+  }
+}
+pub struct FoodPelletStateArgs<'a> {
+    pub id: Option<::flatbuffers::WIPOffset<&'a str>>,
+    pub pos: Option<&'a Vec2f>,
+}
+impl<'a> Default for FoodPelletStateArgs<'a> {
+  #[inline]
+  fn default() -> Self {
+    FoodPelletStateArgs {
+      id: None, // required field
+      pos: None, // required field
+    }
+  }
+}
+
+pub struct FoodPelletStateBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
+}
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> FoodPelletStateBuilder<'a, 'b, A> {
+  #[inline]
+  pub fn add_id(&mut self, id: ::flatbuffers::WIPOffset<&'b  str>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(FoodPelletState::VT_ID, id);
+  }
+  #[inline]
+  pub fn add_pos(&mut self, pos: &Vec2f) {
+    self.fbb_.push_slot_always::<&Vec2f>(FoodPelletState::VT_POS, pos);
+  }
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> FoodPelletStateBuilder<'a, 'b, A> {
+    let start = _fbb.start_table();
+    FoodPelletStateBuilder {
+      fbb_: _fbb,
+      start_: start,
+    }
+  }
+  #[inline]
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<FoodPelletState<'a>> {
+    let o = self.fbb_.end_table(self.start_);
+    self.fbb_.required(o, FoodPelletState::VT_ID,"id");
+    self.fbb_.required(o, FoodPelletState::VT_POS,"pos");
+    ::flatbuffers::WIPOffset::new(o.value())
+  }
+}
+
+impl ::core::fmt::Debug for FoodPelletState<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("FoodPelletState");
+      ds.field("id", &self.id());
+      ds.field("pos", &self.pos());
+      ds.finish()
+  }
+}
+pub enum FishStateOffset {}
+#[derive(Copy, Clone, PartialEq)]
+
+pub struct FishState<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
+}
+
+impl<'a> ::flatbuffers::Follow<'a> for FishState<'a> {
+  type Inner = FishState<'a>;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
+  }
+}
+
+impl<'a> FishState<'a> {
+  pub const VT_ID: ::flatbuffers::VOffsetT = 4;
+  pub const VT_POSITION: ::flatbuffers::VOffsetT = 6;
+  pub const VT_VELOCITY: ::flatbuffers::VOffsetT = 8;
+  pub const VT_ENERGY: ::flatbuffers::VOffsetT = 10;
+  pub const VT_AGE_TICKS: ::flatbuffers::VOffsetT = 12;
+  pub const VT_SPECIES: ::flatbuffers::VOffsetT = 14;
+
+  #[inline]
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    FishState { _tab: table }
+  }
+  #[allow(unused_mut)]
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args FishStateArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<FishState<'bldr>> {
+    let mut builder = FishStateBuilder::new(_fbb);
+    builder.add_age_ticks(args.age_ticks);
+    builder.add_energy(args.energy);
+    if let Some(x) = args.velocity { builder.add_velocity(x); }
+    if let Some(x) = args.position { builder.add_position(x); }
+    builder.add_id(args.id);
+    builder.add_species(args.species);
+    builder.finish()
+  }
+
+
+  #[inline]
+  pub fn id(&self) -> u32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<u32>(FishState::VT_ID, Some(0)).unwrap()}
+  }
+  #[inline]
+  pub fn position(&self) -> Option<&'a Vec2f> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Vec2f>(FishState::VT_POSITION, None)}
+  }
+  #[inline]
+  pub fn velocity(&self) -> Option<&'a Vec2f> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Vec2f>(FishState::VT_VELOCITY, None)}
+  }
+  #[inline]
+  pub fn energy(&self) -> f32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<f32>(FishState::VT_ENERGY, Some(0.0)).unwrap()}
+  }
+  #[inline]
+  pub fn age_ticks(&self) -> u32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<u32>(FishState::VT_AGE_TICKS, Some(0)).unwrap()}
+  }
+  #[inline]
+  pub fn species(&self) -> Species {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Species>(FishState::VT_SPECIES, Some(Species::Unknown)).unwrap()}
+  }
+}
+
+impl ::flatbuffers::Verifiable for FishState<'_> {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.visit_table(pos)?
+     .visit_field::<u32>("id", Self::VT_ID, false)?
+     .visit_field::<Vec2f>("position", Self::VT_POSITION, false)?
+     .visit_field::<Vec2f>("velocity", Self::VT_VELOCITY, false)?
+     .visit_field::<f32>("energy", Self::VT_ENERGY, false)?
+     .visit_field::<u32>("age_ticks", Self::VT_AGE_TICKS, false)?
+     .visit_field::<Species>("species", Self::VT_SPECIES, false)?
+     .finish();
+    Ok(())
+  }
+}
+pub struct FishStateArgs<'a> {
+    pub id: u32,
+    pub position: Option<&'a Vec2f>,
+    pub velocity: Option<&'a Vec2f>,
+    pub energy: f32,
+    pub age_ticks: u32,
+    pub species: Species,
+}
+impl<'a> Default for FishStateArgs<'a> {
+  #[inline]
+  fn default() -> Self {
+    FishStateArgs {
+      id: 0,
+      position: None,
+      velocity: None,
+      energy: 0.0,
+      age_ticks: 0,
+      species: Species::Unknown,
+    }
+  }
+}
+
+pub struct FishStateBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
+}
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> FishStateBuilder<'a, 'b, A> {
+  #[inline]
+  pub fn add_id(&mut self, id: u32) {
+    self.fbb_.push_slot::<u32>(FishState::VT_ID, id, 0);
+  }
+  #[inline]
+  pub fn add_position(&mut self, position: &Vec2f) {
+    self.fbb_.push_slot_always::<&Vec2f>(FishState::VT_POSITION, position);
+  }
+  #[inline]
+  pub fn add_velocity(&mut self, velocity: &Vec2f) {
+    self.fbb_.push_slot_always::<&Vec2f>(FishState::VT_VELOCITY, velocity);
+  }
+  #[inline]
+  pub fn add_energy(&mut self, energy: f32) {
+    self.fbb_.push_slot::<f32>(FishState::VT_ENERGY, energy, 0.0);
+  }
+  #[inline]
+  pub fn add_age_ticks(&mut self, age_ticks: u32) {
+    self.fbb_.push_slot::<u32>(FishState::VT_AGE_TICKS, age_ticks, 0);
+  }
+  #[inline]
+  pub fn add_species(&mut self, species: Species) {
+    self.fbb_.push_slot::<Species>(FishState::VT_SPECIES, species, Species::Unknown);
+  }
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> FishStateBuilder<'a, 'b, A> {
+    let start = _fbb.start_table();
+    FishStateBuilder {
+      fbb_: _fbb,
+      start_: start,
+    }
+  }
+  #[inline]
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<FishState<'a>> {
+    let o = self.fbb_.end_table(self.start_);
+    ::flatbuffers::WIPOffset::new(o.value())
+  }
+}
+
+impl ::core::fmt::Debug for FishState<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("FishState");
+      ds.field("id", &self.id());
+      ds.field("position", &self.position());
+      ds.field("velocity", &self.velocity());
+      ds.field("energy", &self.energy());
+      ds.field("age_ticks", &self.age_ticks());
+      ds.field("species", &self.species());
+      ds.finish()
+  }
+}
+pub enum FoodStateOffset {}
+#[derive(Copy, Clone, PartialEq)]
+
+pub struct FoodState<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
+}
+
+impl<'a> ::flatbuffers::Follow<'a> for FoodState<'a> {
+  type Inner = FoodState<'a>;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
+  }
+}
+
+impl<'a> FoodState<'a> {
+  pub const VT_ID: ::flatbuffers::VOffsetT = 4;
+  pub const VT_POSITION: ::flatbuffers::VOffsetT = 6;
+  pub const VT_NUTRITION: ::flatbuffers::VOffsetT = 8;
+
+  #[inline]
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    FoodState { _tab: table }
+  }
+  #[allow(unused_mut)]
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args FoodStateArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<FoodState<'bldr>> {
+    let mut builder = FoodStateBuilder::new(_fbb);
+    builder.add_nutrition(args.nutrition);
+    if let Some(x) = args.position { builder.add_position(x); }
+    builder.add_id(args.id);
+    builder.finish()
+  }
+
+
+  #[inline]
+  pub fn id(&self) -> u32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<u32>(FoodState::VT_ID, Some(0)).unwrap()}
+  }
+  #[inline]
+  pub fn position(&self) -> Option<&'a Vec2f> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Vec2f>(FoodState::VT_POSITION, None)}
+  }
+  #[inline]
+  pub fn nutrition(&self) -> f32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<f32>(FoodState::VT_NUTRITION, Some(0.0)).unwrap()}
+  }
+}
+
+impl ::flatbuffers::Verifiable for FoodState<'_> {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.visit_table(pos)?
+     .visit_field::<u32>("id", Self::VT_ID, false)?
+     .visit_field::<Vec2f>("position", Self::VT_POSITION, false)?
+     .visit_field::<f32>("nutrition", Self::VT_NUTRITION, false)?
+     .finish();
+    Ok(())
+  }
+}
+pub struct FoodStateArgs<'a> {
+    pub id: u32,
+    pub position: Option<&'a Vec2f>,
+    pub nutrition: f32,
+}
+impl<'a> Default for FoodStateArgs<'a> {
+  #[inline]
+  fn default() -> Self {
+    FoodStateArgs {
+      id: 0,
+      position: None,
+      nutrition: 0.0,
+    }
+  }
+}
+
+pub struct FoodStateBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
+}
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> FoodStateBuilder<'a, 'b, A> {
+  #[inline]
+  pub fn add_id(&mut self, id: u32) {
+    self.fbb_.push_slot::<u32>(FoodState::VT_ID, id, 0);
+  }
+  #[inline]
+  pub fn add_position(&mut self, position: &Vec2f) {
+    self.fbb_.push_slot_always::<&Vec2f>(FoodState::VT_POSITION, position);
+  }
+  #[inline]
+  pub fn add_nutrition(&mut self, nutrition: f32) {
+    self.fbb_.push_slot::<f32>(FoodState::VT_NUTRITION, nutrition, 0.0);
+  }
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> FoodStateBuilder<'a, 'b, A> {
+    let start = _fbb.start_table();
+    FoodStateBuilder {
+      fbb_: _fbb,
+      start_: start,
+    }
+  }
+  #[inline]
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<FoodState<'a>> {
+    let o = self.fbb_.end_table(self.start_);
+    ::flatbuffers::WIPOffset::new(o.value())
+  }
+}
+
+impl ::core::fmt::Debug for FoodState<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("FoodState");
+      ds.field("id", &self.id());
+      ds.field("position", &self.position());
+      ds.field("nutrition", &self.nutrition());
+      ds.finish()
+  }
+}
+pub enum PlantStateOffset {}
+#[derive(Copy, Clone, PartialEq)]
+
+pub struct PlantState<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
+}
+
+impl<'a> ::flatbuffers::Follow<'a> for PlantState<'a> {
+  type Inner = PlantState<'a>;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
+  }
+}
+
+impl<'a> PlantState<'a> {
+  pub const VT_ID: ::flatbuffers::VOffsetT = 4;
+  pub const VT_POSITION: ::flatbuffers::VOffsetT = 6;
+
+  #[inline]
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    PlantState { _tab: table }
+  }
+  #[allow(unused_mut)]
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args PlantStateArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<PlantState<'bldr>> {
+    let mut builder = PlantStateBuilder::new(_fbb);
+    if let Some(x) = args.position { builder.add_position(x); }
+    builder.add_id(args.id);
+    builder.finish()
+  }
+
+
+  #[inline]
+  pub fn id(&self) -> u32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<u32>(PlantState::VT_ID, Some(0)).unwrap()}
+  }
+  #[inline]
+  pub fn position(&self) -> Option<&'a Vec2f> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Vec2f>(PlantState::VT_POSITION, None)}
+  }
+}
+
+impl ::flatbuffers::Verifiable for PlantState<'_> {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.visit_table(pos)?
+     .visit_field::<u32>("id", Self::VT_ID, false)?
+     .visit_field::<Vec2f>("position", Self::VT_POSITION, false)?
+     .finish();
+    Ok(())
+  }
+}
+pub struct PlantStateArgs<'a> {
+    pub id: u32,
+    pub position: Option<&'a Vec2f>,
+}
+impl<'a> Default for PlantStateArgs<'a> {
+  #[inline]
+  fn default() -> Self {
+    PlantStateArgs {
+      id: 0,
+      position: None,
+    }
+  }
+}
+
+pub struct PlantStateBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
+}
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> PlantStateBuilder<'a, 'b, A> {
+  #[inline]
+  pub fn add_id(&mut self, id: u32) {
+    self.fbb_.push_slot::<u32>(PlantState::VT_ID, id, 0);
+  }
+  #[inline]
+  pub fn add_position(&mut self, position: &Vec2f) {
+    self.fbb_.push_slot_always::<&Vec2f>(PlantState::VT_POSITION, position);
+  }
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> PlantStateBuilder<'a, 'b, A> {
+    let start = _fbb.start_table();
+    PlantStateBuilder {
+      fbb_: _fbb,
+      start_: start,
+    }
+  }
+  #[inline]
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<PlantState<'a>> {
+    let o = self.fbb_.end_table(self.start_);
+    ::flatbuffers::WIPOffset::new(o.value())
+  }
+}
+
+impl ::core::fmt::Debug for PlantState<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("PlantState");
+      ds.field("id", &self.id());
+      ds.field("position", &self.position());
+      ds.finish()
+  }
+}
+pub enum FrameEntityOffset {}
+#[derive(Copy, Clone, PartialEq)]
+
+pub struct FrameEntity<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
+}
+
+impl<'a> ::flatbuffers::Follow<'a> for FrameEntity<'a> {
+  type Inner = FrameEntity<'a>;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
+  }
+}
+
+impl<'a> FrameEntity<'a> {
+  pub const VT_ENTITY_TYPE: ::flatbuffers::VOffsetT = 4;
+  pub const VT_ENTITY: ::flatbuffers::VOffsetT = 6;
+
+  #[inline]
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    FrameEntity { _tab: table }
+  }
+  #[allow(unused_mut)]
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args FrameEntityArgs
+  ) -> ::flatbuffers::WIPOffset<FrameEntity<'bldr>> {
+    let mut builder = FrameEntityBuilder::new(_fbb);
+    if let Some(x) = args.entity { builder.add_entity(x); }
+    builder.add_entity_type(args.entity_type);
+    builder.finish()
+  }
+
+
+  #[inline]
+  pub fn entity_type(&self) -> Entity {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<Entity>(FrameEntity::VT_ENTITY_TYPE, Some(Entity::NONE)).unwrap()}
+  }
+  #[inline]
+  pub fn entity(&self) -> Option<::flatbuffers::Table<'a>> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<::flatbuffers::Table<'a>>>(FrameEntity::VT_ENTITY, None)}
+  }
+  #[inline]
+  #[allow(non_snake_case)]
+  pub fn entity_as_fish_state(&self) -> Option<FishState<'a>> {
+    if self.entity_type() == Entity::FishState {
+      self.entity().map(|t| {
+       // Safety:
+       // Created from a valid Table for this object
+       // Which contains a valid union in this slot
+       unsafe { FishState::init_from_table(t) }
+     })
+    } else {
+      None
+    }
+  }
 
-  extern crate flatbuffers;
-  use self::flatbuffers::{EndianScalar, Follow};
-#[allow(unused_imports, dead_code)]
-pub mod state {
+  #[inline]
+  #[allow(non_snake_case)]
+  pub fn entity_as_food_state(&self) -> Option<FoodState<'a>> {
+    if self.entity_type() == Entity::FoodState {
+      self.entity().map(|t| {
+       // Safety:
+       // Created from a valid Table for this object
+       // Which contains a valid union in this slot
+       unsafe { FoodState::init_from_table(t) }
+     })
+    } else {
+      None
+    }
+  }
 
-  use core::mem;
-  use core::cmp::Ordering;
+  #[inline]
+  #[allow(non_snake_case)]
+  pub fn entity_as_plant_state(&self) -> Option<PlantState<'a>> {
+    if self.entity_type() == Entity::PlantState {
+      self.entity().map(|t| {
+       // Safety:
+       // Created from a valid Table for this object
+       // Which contains a valid union in this slot
+       unsafe { PlantState::init_from_table(t) }
+     })
+    } else {
+      None
+    }
+  }
 
-  extern crate flatbuffers;
-  use self::flatbuffers::{EndianScalar, Follow};
+}
 
-// struct Vec2f, aligned to 4
-#[repr(transparent)]
-#[derive(Clone, Copy, PartialEq)]
-pub struct Vec2f(pub [u8; 8]);
-impl Default for Vec2f { 
-  fn default() -> Self { 
-    Self([0; 8])
+impl ::flatbuffers::Verifiable for FrameEntity<'_> {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.visit_table(pos)?
+     .visit_union::<Entity, _>("entity_type", Self::VT_ENTITY_TYPE, "entity", Self::VT_ENTITY, false, |key, v, pos| {
+        match key {
+          Entity::FishState => v.verify_union_variant::<::flatbuffers::ForwardsUOffset<FishState>>("Entity::FishState", pos),
+          Entity::FoodState => v.verify_union_variant::<::flatbuffers::ForwardsUOffset<FoodState>>("Entity::FoodState", pos),
+          Entity::PlantState => v.verify_union_variant::<::flatbuffers::ForwardsUOffset<PlantState>>("Entity::PlantState", pos),
+          _ => Ok(()),
+        }
+     })?
+     .finish();
+    Ok(())
   }
 }
-impl core::fmt::Debug for Vec2f {
-  fn fmt(&self, f: &mut core::fmt::Formatter) -> core::fmt::Result {
-    f.debug_struct("Vec2f")
-      .field("x", &self.x())
-      .field("y", &self.y())
-      .finish()
+pub struct FrameEntityArgs {
+    pub entity_type: Entity,
+    pub entity: Option<::flatbuffers::WIPOffset<::flatbuffers::UnionWIPOffset>>,
+}
+impl<'a> Default for FrameEntityArgs {
+  #[inline]
+  fn default() -> Self {
+    FrameEntityArgs {
+      entity_type: Entity::NONE,
+      entity: None,
+    }
   }
 }
 
-impl flatbuffers::SimpleToVerifyInSlice for Vec2f {}
-impl<'a> flatbuffers::Follow<'a> for Vec2f {
-  type Inner = &'a Vec2f;
+pub struct FrameEntityBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
+}
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> FrameEntityBuilder<'a, 'b, A> {
   #[inline]
-  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
-    <&'a Vec2f>::follow(buf, loc)
+  pub fn add_entity_type(&mut self, entity_type: Entity) {
+    self.fbb_.push_slot::<Entity>(FrameEntity::VT_ENTITY_TYPE, entity_type, Entity::NONE);
   }
-}
-impl<'a> flatbuffers::Follow<'a> for &'a Vec2f {
-  type Inner = &'a Vec2f;
   #[inline]
-  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
-    flatbuffers::follow_cast_ref::<Vec2f>(buf, loc)
+  pub fn add_entity(&mut self, entity: ::flatbuffers::WIPOffset<::flatbuffers::UnionWIPOffset>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(FrameEntity::VT_ENTITY, entity);
   }
-}
-impl<'b> flatbuffers::Push for Vec2f {
-    type Output = Vec2f;
-    #[inline]
-    unsafe fn push(&self, dst: &mut [u8], _written_len: usize) {
-        let src = ::core::slice::from_raw_parts(self as *const Vec2f as *const u8, <Self as flatbuffers::Push>::size());
-        dst.copy_from_slice(src);
-    }
-    #[inline]
-    fn alignment() -> flatbuffers::PushAlignment {
-        flatbuffers::PushAlignment::new(4)
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> FrameEntityBuilder<'a, 'b, A> {
+    let start = _fbb.start_table();
+    FrameEntityBuilder {
+      fbb_: _fbb,
+      start_: start,
     }
+  }
+  #[inline]
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<FrameEntity<'a>> {
+    let o = self.fbb_.end_table(self.start_);
+    ::flatbuffers::WIPOffset::new(o.value())
+  }
 }
 
-impl<'a> flatbuffers::Verifiable for Vec2f {
-  #[inline]
-  fn run_verifier(
-    v: &mut flatbuffers::Verifier, pos: usize
-  ) -> Result<(), flatbuffers::InvalidFlatbuffer> {
-    use self::flatbuffers::Verifiable;
-    v.in_buffer::<Self>(pos)
+impl ::core::fmt::Debug for FrameEntity<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("FrameEntity");
+      ds.field("entity_type", &self.entity_type());
+      match self.entity_type() {
+        Entity::FishState => {
+          if let Some(x) = self.entity_as_fish_state() {
+            ds.field("entity", &x)
+          } else {
+            ds.field("entity", &"InvalidFlatbuffer: Union discriminant does not match value.")
+          }
+        },
+        Entity::FoodState => {
+          if let Some(x) = self.entity_as_food_state() {
+            ds.field("entity", &x)
+          } else {
+            ds.field("entity", &"InvalidFlatbuffer: Union discriminant does not match value.")
+          }
+        },
+        Entity::PlantState => {
+          if let Some(x) = self.entity_as_plant_state() {
+            ds.field("entity", &x)
+          } else {
+            ds.field("entity", &"InvalidFlatbuffer: Union discriminant does not match value.")
+          }
+        },
+        _ => {
+          let x: Option<()> = None;
+          ds.field("entity", &x)
+        },
+      };
+      ds.finish()
   }
 }
+pub enum ObstacleOffset {}
+#[derive(Copy, Clone, PartialEq)]
 
-impl<'a> Vec2f {
-  #[allow(clippy::too_many_arguments)]
-  pub fn new(
-    x: f32,
-    y: f32,
-  ) -> Self {
-    let mut s = Self([0; 8]);
-    s.set_x(x);
-    s.set_y(y);
-    s
+pub struct Obstacle<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
+}
+
+impl<'a> ::flatbuffers::Follow<'a> for Obstacle<'a> {
+  type Inner = Obstacle<'a>;
+  #[inline]
+  unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
   }
+}
 
-  pub fn x(&self) -> f32 {
-    let mut mem = core::mem::MaybeUninit::<<f32 as EndianScalar>::Scalar>::uninit();
-    // Safety:
-    // Created from a valid Table for this object
-    // Which contains a valid value in this slot
-    EndianScalar::from_little_endian(unsafe {
-      core::ptr::copy_nonoverlapping(
-        self.0[0..].as_ptr(),
-        mem.as_mut_ptr() as *mut u8,
-        core::mem::size_of::<<f32 as EndianScalar>::Scalar>(),
-      );
-      mem.assume_init()
-    })
+impl<'a> Obstacle<'a> {
+  pub const VT_ID: ::flatbuffers::VOffsetT = 4;
+  pub const VT_BOUNDS: ::flatbuffers::VOffsetT = 6;
+
+  #[inline]
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    Obstacle { _tab: table }
+  }
+  #[allow(unused_mut)]
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args ObstacleArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<Obstacle<'bldr>> {
+    let mut builder = ObstacleBuilder::new(_fbb);
+    if let Some(x) = args.bounds { builder.add_bounds(x); }
+    builder.add_id(args.id);
+    builder.finish()
   }
 
-  pub fn set_x(&mut self, x: f32) {
-    let x_le = x.to_little_endian();
+
+  #[inline]
+  pub fn id(&self) -> u32 {
     // Safety:
-    // Created from a valid Table for this object
-    // Which contains a valid value in this slot
-    unsafe {
-      core::ptr::copy_nonoverlapping(
-        &x_le as *const _ as *const u8,
-        self.0[0..].as_mut_ptr(),
-        core::mem::size_of::<<f32 as EndianScalar>::Scalar>(),
-      );
-    }
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<u32>(Obstacle::VT_ID, Some(0)).unwrap()}
   }
-
-  pub fn y(&self) -> f32 {
-    let mut mem = core::mem::MaybeUninit::<<f32 as EndianScalar>::Scalar>::uninit();
+  #[inline]
+  pub fn bounds(&self) -> Option<&'a AABB> {
     // Safety:
-    // Created from a valid Table for this object
-    // Which contains a valid value in this slot
-    EndianScalar::from_little_endian(unsafe {
-      core::ptr::copy_nonoverlapping(
-        self.0[4..].as_ptr(),
-        mem.as_mut_ptr() as *mut u8,
-        core::mem::size_of::<<f32 as EndianScalar>::Scalar>(),
-      );
-      mem.assume_init()
-    })
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<AABB>(Obstacle::VT_BOUNDS, None)}
   }
+}
 
-  pub fn set_y(&mut self, x: f32) {
-    let x_le = x.to_little_endian();
-    // Safety:
-    // Created from a valid Table for this object
-    // Which contains a valid value in this slot
-    unsafe {
-      core::ptr::copy_nonoverlapping(
-        &x_le as *const _ as *const u8,
-        self.0[4..].as_mut_ptr(),
-        core::mem::size_of::<<f32 as EndianScalar>::Scalar>(),
-      );
+impl ::flatbuffers::Verifiable for Obstacle<'_> {
+  #[inline]
+  fn run_verifier(
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
+    v.visit_table(pos)?
+     .visit_field::<u32>("id", Self::VT_ID, false)?
+     .visit_field::<AABB>("bounds", Self::VT_BOUNDS, false)?
+     .finish();
+    Ok(())
+  }
+}
+pub struct ObstacleArgs<'a> {
+    pub id: u32,
+    pub bounds: Option<&'a AABB>,
+}
+impl<'a> Default for ObstacleArgs<'a> {
+  #[inline]
+  fn default() -> Self {
+    ObstacleArgs {
+      id: 0,
+      bounds: None,
     }
   }
+}
 
+pub struct ObstacleBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
+}
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> ObstacleBuilder<'a, 'b, A> {
+  #[inline]
+  pub fn add_id(&mut self, id: u32) {
+    self.fbb_.push_slot::<u32>(Obstacle::VT_ID, id, 0);
+  }
+  #[inline]
+  pub fn add_bounds(&mut self, bounds: &AABB) {
+    self.fbb_.push_slot_always::<&AABB>(Obstacle::VT_BOUNDS, bounds);
+  }
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> ObstacleBuilder<'a, 'b, A> {
+    let start = _fbb.start_table();
+    ObstacleBuilder {
+      fbb_: _fbb,
+      start_: start,
+    }
+  }
+  #[inline]
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<Obstacle<'a>> {
+    let o = self.fbb_.end_table(self.start_);
+    ::flatbuffers::WIPOffset::new(o.value())
+  }
 }
 
-pub enum AgentStateOffset {}
+impl ::core::fmt::Debug for Obstacle<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("Obstacle");
+      ds.field("id", &self.id());
+      ds.field("bounds", &self.bounds());
+      ds.finish()
+  }
+}
+pub enum FlowFieldOffset {}
 #[derive(Copy, Clone, PartialEq)]
 
-pub struct AgentState<'a> {
-  pub _tab: flatbuffers::Table<'a>,
+pub struct FlowField<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
 }
 
-impl<'a> flatbuffers::Follow<'a> for AgentState<'a> {
-  type Inner = AgentState<'a>;
+impl<'a> ::flatbuffers::Follow<'a> for FlowField<'a> {
+  type Inner = FlowField<'a>;
   #[inline]
   unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
-    Self { _tab: flatbuffers::Table::new(buf, loc) }
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
   }
 }
 
-impl<'a> AgentState<'a> {
-  pub const VT_ID: flatbuffers::VOffsetT = 4;
-  pub const VT_POS: flatbuffers::VOffsetT = 6;
+impl<'a> FlowField<'a> {
+  pub const VT_BOUNDS: ::flatbuffers::VOffsetT = 4;
+  pub const VT_COLS: ::flatbuffers::VOffsetT = 6;
+  pub const VT_ROWS: ::flatbuffers::VOffsetT = 8;
+  pub const VT_VECTORS: ::flatbuffers::VOffsetT = 10;
 
   #[inline]
-  pub unsafe fn init_from_table(table: flatbuffers::Table<'a>) -> Self {
-    AgentState { _tab: table }
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    FlowField { _tab: table }
   }
   #[allow(unused_mut)]
-  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: flatbuffers::Allocator + 'bldr>(
-    _fbb: &'mut_bldr mut flatbuffers::FlatBufferBuilder<'bldr, A>,
-    args: &'args AgentStateArgs<'args>
-  ) -> flatbuffers::WIPOffset<AgentState<'bldr>> {
-    let mut builder = AgentStateBuilder::new(_fbb);
-    if let Some(x) = args.pos { builder.add_pos(x); }
-    if let Some(x) = args.id { builder.add_id(x); }
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args FlowFieldArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<FlowField<'bldr>> {
+    let mut builder = FlowFieldBuilder::new(_fbb);
+    if let Some(x) = args.vectors { builder.add_vectors(x); }
+    builder.add_rows(args.rows);
+    builder.add_cols(args.cols);
+    if let Some(x) = args.bounds { builder.add_bounds(x); }
     builder.finish()
   }
 
 
   #[inline]
-  pub fn id(&self) -> &'a str {
+  pub fn bounds(&self) -> Option<&'a AABB> {
     // Safety:
     // Created from valid Table for this object
     // which contains a valid value in this slot
-    unsafe { self._tab.get::<flatbuffers::ForwardsUOffset<&str>>(AgentState::VT_ID, None).unwrap()}
+    unsafe { self._tab.get::<AABB>(FlowField::VT_BOUNDS, None)}
   }
   #[inline]
-  pub fn key_compare_less_than(&self, o: &AgentState) -> bool {
-    self.id() < o.id()
+  pub fn cols(&self) -> u32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<u32>(FlowField::VT_COLS, Some(0)).unwrap()}
   }
-
   #[inline]
-  pub fn key_compare_with_value(&self, val: & str) -> ::core::cmp::Ordering {
-    let key = self.id();
-    key.cmp(val)
+  pub fn rows(&self) -> u32 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<u32>(FlowField::VT_ROWS, Some(0)).unwrap()}
   }
   #[inline]
-  pub fn pos(&self) -> &'a Vec2f {
+  pub fn vectors(&self) -> Option<::flatbuffers::Vector<'a, Vec2f>> {
     // Safety:
     // Created from valid Table for this object
     // which contains a valid value in this slot
-    unsafe { self._tab.get::<Vec2f>(AgentState::VT_POS, None).unwrap()}
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'a, Vec2f>>>(FlowField::VT_VECTORS, None)}
   }
 }
 
-impl flatbuffers::Verifiable for AgentState<'_> {
+impl ::flatbuffers::Verifiable for FlowField<'_> {
   #[inline]
   fn run_verifier(
-    v: &mut flatbuffers::Verifier, pos: usize
-  ) -> Result<(), flatbuffers::InvalidFlatbuffer> {
-    use self::flatbuffers::Verifiable;
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
     v.visit_table(pos)?
-     .visit_field::<flatbuffers::ForwardsUOffset<&str>>("id", Self::VT_ID, true)?
-     .visit_field::<Vec2f>("pos", Self::VT_POS, true)?
+     .visit_field::<AABB>("bounds", Self::VT_BOUNDS, false)?
+     .visit_field::<u32>("cols", Self::VT_COLS, false)?
+     .visit_field::<u32>("rows", Self::VT_ROWS, false)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'_, Vec2f>>>("vectors", Self::VT_VECTORS, false)?
      .finish();
     Ok(())
   }
 }
-pub struct AgentStateArgs<'a> {
-    pub id: Option<flatbuffers::WIPOffset<&'a str>>,
-    pub pos: Option<&'a Vec2f>,
+pub struct FlowFieldArgs<'a> {
+    pub bounds: Option<&'a AABB>,
+    pub cols: u32,
+    pub rows: u32,
+    pub vectors: Option<::flatbuffers::WIPOffset<::flatbuffers::Vector<'a, Vec2f>>>,
 }
-impl<'a> Default for AgentStateArgs<'a> {
+impl<'a> Default for FlowFieldArgs<'a> {
   #[inline]
   fn default() -> Self {
-    AgentStateArgs {
-      id: None, // required field
-      pos: None, // required field
+    FlowFieldArgs {
+      bounds: None,
+      cols: 0,
+      rows: 0,
+      vectors: None,
     }
   }
 }
 
-pub struct AgentStateBuilder<'a: 'b, 'b, A: flatbuffers::Allocator + 'a> {
-  fbb_: &'b mut flatbuffers::FlatBufferBuilder<'a, A>,
-  start_: flatbuffers::WIPOffset<flatbuffers::TableUnfinishedWIPOffset>,
+pub struct FlowFieldBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
 }
-impl<'a: 'b, 'b, A: flatbuffers::Allocator + 'a> AgentStateBuilder<'a, 'b, A> {
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> FlowFieldBuilder<'a, 'b, A> {
   #[inline]
-  pub fn add_id(&mut self, id: flatbuffers::WIPOffset<&'b  str>) {
-    self.fbb_.push_slot_always::<flatbuffers::WIPOffset<_>>(AgentState::VT_ID, id);
+  pub fn add_bounds(&mut self, bounds: &AABB) {
+    self.fbb_.push_slot_always::<&AABB>(FlowField::VT_BOUNDS, bounds);
   }
   #[inline]
-  pub fn add_pos(&mut self, pos: &Vec2f) {
-    self.fbb_.push_slot_always::<&Vec2f>(AgentState::VT_POS, pos);
+  pub fn add_cols(&mut self, cols: u32) {
+    self.fbb_.push_slot::<u32>(FlowField::VT_COLS, cols, 0);
+  }
+  #[inline]
+  pub fn add_rows(&mut self, rows: u32) {
+    self.fbb_.push_slot::<u32>(FlowField::VT_ROWS, rows, 0);
   }
   #[inline]
-  pub fn new(_fbb: &'b mut flatbuffers::FlatBufferBuilder<'a, A>) -> AgentStateBuilder<'a, 'b, A> {
+  pub fn add_vectors(&mut self, vectors: ::flatbuffers::WIPOffset<::flatbuffers::Vector<'b , Vec2f>>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(FlowField::VT_VECTORS, vectors);
+  }
+  #[inline]
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> FlowFieldBuilder<'a, 'b, A> {
     let start = _fbb.start_table();
-    AgentStateBuilder {
+    FlowFieldBuilder {
       fbb_: _fbb,
       start_: start,
     }
   }
   #[inline]
-  pub fn finish(self) -> flatbuffers::WIPOffset<AgentState<'a>> {
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<FlowField<'a>> {
     let o = self.fbb_.end_table(self.start_);
-    self.fbb_.required(o, AgentState::VT_ID,"id");
-    self.fbb_.required(o, AgentState::VT_POS,"pos");
-    flatbuffers::WIPOffset::new(o.value())
+    ::flatbuffers::WIPOffset::new(o.value())
   }
 }
 
-impl core::fmt::Debug for AgentState<'_> {
-  fn fmt(&self, f: &mut core::fmt::Formatter<'_>) -> core::fmt::Result {
-    let mut ds = f.debug_struct("AgentState");
-      ds.field("id", &self.id());
-      ds.field("pos", &self.pos());
+impl ::core::fmt::Debug for FlowField<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("FlowField");
+      ds.field("bounds", &self.bounds());
+      ds.field("cols", &self.cols());
+      ds.field("rows", &self.rows());
+      ds.field("vectors", &self.vectors());
       ds.finish()
   }
 }
-pub enum FoodPelletStateOffset {}
+pub enum FrameOffset {}
 #[derive(Copy, Clone, PartialEq)]
 
-pub struct FoodPelletState<'a> {
-  pub _tab: flatbuffers::Table<'a>,
+pub struct Frame<'a> {
+  pub _tab: ::flatbuffers::Table<'a>,
 }
 
-impl<'a> flatbuffers::Follow<'a> for FoodPelletState<'a> {
-  type Inner = FoodPelletState<'a>;
+impl<'a> ::flatbuffers::Follow<'a> for Frame<'a> {
+  type Inner = Frame<'a>;
   #[inline]
   unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
-    Self { _tab: flatbuffers::Table::new(buf, loc) }
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
   }
 }
 
-impl<'a> FoodPelletState<'a> {
-  pub const VT_ID: flatbuffers::VOffsetT = 4;
-  pub const VT_POS: flatbuffers::VOffsetT = 6;
+impl<'a> Frame<'a> {
+  pub const VT_TICK: ::flatbuffers::VOffsetT = 4;
+  pub const VT_TIMESTAMP_NS: ::flatbuffers::VOffsetT = 6;
+  pub const VT_ENTITIES: ::flatbuffers::VOffsetT = 8;
+  pub const VT_SCHEMA_VERSION: ::flatbuffers::VOffsetT = 10;
+  pub const VT_BOUNDS: ::flatbuffers::VOffsetT = 12;
+  pub const VT_OBSTACLES: ::flatbuffers::VOffsetT = 14;
 
   #[inline]
-  pub unsafe fn init_from_table(table: flatbuffers::Table<'a>) -> Self {
-    FoodPelletState { _tab: table }
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
+    Frame { _tab: table }
   }
   #[allow(unused_mut)]
-  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: flatbuffers::Allocator + 'bldr>(
-    _fbb: &'mut_bldr mut flatbuffers::FlatBufferBuilder<'bldr, A>,
-    args: &'args FoodPelletStateArgs<'args>
-  ) -> flatbuffers::WIPOffset<FoodPelletState<'bldr>> {
-    let mut builder = FoodPelletStateBuilder::new(_fbb);
-    if let Some(x) = args.pos { builder.add_pos(x); }
-    if let Some(x) = args.id { builder.add_id(x); }
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
+    args: &'args FrameArgs<'args>
+  ) -> ::flatbuffers::WIPOffset<Frame<'bldr>> {
+    let mut builder = FrameBuilder::new(_fbb);
+    builder.add_timestamp_ns(args.timestamp_ns);
+    builder.add_tick(args.tick);
+    if let Some(x) = args.obstacles { builder.add_obstacles(x); }
+    if let Some(x) = args.bounds { builder.add_bounds(x); }
+    if let Some(x) = args.entities { builder.add_entities(x); }
+    builder.add_schema_version(args.schema_version);
     builder.finish()
   }
 
 
   #[inline]
-  pub fn id(&self) -> &'a str {
+  pub fn tick(&self) -> u64 {
     // Safety:
     // Created from valid Table for this object
     // which contains a valid value in this slot
-    unsafe { self._tab.get::<flatbuffers::ForwardsUOffset<&str>>(FoodPelletState::VT_ID, None).unwrap()}
+    unsafe { self._tab.get::<u64>(Frame::VT_TICK, Some(0)).unwrap()}
   }
   #[inline]
-  pub fn key_compare_less_than(&self, o: &FoodPelletState) -> bool {
-    self.id() < o.id()
+  pub fn timestamp_ns(&self) -> i64 {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<i64>(Frame::VT_TIMESTAMP_NS, Some(0)).unwrap()}
   }
-
   #[inline]
-  pub fn key_compare_with_value(&self, val: & str) -> ::core::cmp::Ordering {
-    let key = self.id();
-    key.cmp(val)
+  pub fn entities(&self) -> Option<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<FrameEntity<'a>>>> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<FrameEntity>>>>(Frame::VT_ENTITIES, None)}
   }
   #[inline]
-  pub fn pos(&self) -> &'a Vec2f {
+  pub fn schema_version(&self) -> u16 {
     // Safety:
     // Created from valid Table for this object
     // which contains a valid value in this slot
-    unsafe { self._tab.get::<Vec2f>(FoodPelletState::VT_POS, None).unwrap()}
+    unsafe { self._tab.get::<u16>(Frame::VT_SCHEMA_VERSION, Some(0)).unwrap()}
+  }
+  #[inline]
+  pub fn bounds(&self) -> Option<&'a AABB> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<AABB>(Frame::VT_BOUNDS, None)}
+  }
+  #[inline]
+  pub fn obstacles(&self) -> Option<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<Obstacle<'a>>>> {
+    // Safety:
+    // Created from valid Table for this object
+    // which contains a valid value in this slot
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<Obstacle>>>>(Frame::VT_OBSTACLES, None)}
   }
 }
 
-impl flatbuffers::Verifiable for FoodPelletState<'_> {
+impl ::flatbuffers::Verifiable for Frame<'_> {
   #[inline]
   fn run_verifier(
-    v: &mut flatbuffers::Verifier, pos: usize
-  ) -> Result<(), flatbuffers::InvalidFlatbuffer> {
-    use self::flatbuffers::Verifiable;
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
     v.visit_table(pos)?
-     .visit_field::<flatbuffers::ForwardsUOffset<&str>>("id", Self::VT_ID, true)?
-     .visit_field::<Vec2f>("pos", Self::VT_POS, true)?
+     .visit_field::<u64>("tick", Self::VT_TICK, false)?
+     .visit_field::<i64>("timestamp_ns", Self::VT_TIMESTAMP_NS, false)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'_, ::flatbuffers::ForwardsUOffset<FrameEntity>>>>("entities", Self::VT_ENTITIES, false)?
+     .visit_field::<u16>("schema_version", Self::VT_SCHEMA_VERSION, false)?
+     .visit_field::<AABB>("bounds", Self::VT_BOUNDS, false)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'_, ::flatbuffers::ForwardsUOffset<Obstacle>>>>("obstacles", Self::VT_OBSTACLES, false)?
      .finish();
     Ok(())
   }
 }
-pub struct FoodPelletStateArgs<'a> {
-    pub id: Option<flatbuffers::WIPOffset<&'a str>>,
-    pub pos: Option<&'a Vec2f>,
+pub struct FrameArgs<'a> {
+    pub tick: u64,
+    pub timestamp_ns: i64,
+    pub entities: Option<::flatbuffers::WIPOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<FrameEntity<'a>>>>>,
+    pub schema_version: u16,
+    pub bounds: Option<&'a AABB>,
+    pub obstacles: Option<::flatbuffers::WIPOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<Obstacle<'a>>>>>,
 }
-impl<'a> Default for FoodPelletStateArgs<'a> {
+impl<'a> Default for FrameArgs<'a> {
   #[inline]
   fn default() -> Self {
-    FoodPelletStateArgs {
-      id: None, // required field
-      pos: None, // required field
+    FrameArgs {
+      tick: 0,
+      timestamp_ns: 0,
+      entities: None,
+      schema_version: 0,
+      bounds: None,
+      obstacles: None,
     }
   }
 }
 
-pub struct FoodPelletStateBuilder<'a: 'b, 'b, A: flatbuffers::Allocator + 'a> {
-  fbb_: &'b mut flatbuffers::FlatBufferBuilder<'a, A>,
-  start_: flatbuffers::WIPOffset<flatbuffers::TableUnfinishedWIPOffset>,
+pub struct FrameBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
 }
-impl<'a: 'b, 'b, A: flatbuffers::Allocator + 'a> FoodPelletStateBuilder<'a, 'b, A> {
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> FrameBuilder<'a, 'b, A> {
   #[inline]
-  pub fn add_id(&mut self, id: flatbuffers::WIPOffset<&'b  str>) {
-    self.fbb_.push_slot_always::<flatbuffers::WIPOffset<_>>(FoodPelletState::VT_ID, id);
+  pub fn add_tick(&mut self, tick: u64) {
+    self.fbb_.push_slot::<u64>(Frame::VT_TICK, tick, 0);
   }
   #[inline]
-  pub fn add_pos(&mut self, pos: &Vec2f) {
-    self.fbb_.push_slot_always::<&Vec2f>(FoodPelletState::VT_POS, pos);
+  pub fn add_timestamp_ns(&mut self, timestamp_ns: i64) {
+    self.fbb_.push_slot::<i64>(Frame::VT_TIMESTAMP_NS, timestamp_ns, 0);
+  }
+  #[inline]
+  pub fn add_entities(&mut self, entities: ::flatbuffers::WIPOffset<::flatbuffers::Vector<'b , ::flatbuffers::ForwardsUOffset<FrameEntity<'b >>>>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(Frame::VT_ENTITIES, entities);
+  }
+  #[inline]
+  pub fn add_schema_version(&mut self, schema_version: u16) {
+    self.fbb_.push_slot::<u16>(Frame::VT_SCHEMA_VERSION, schema_version, 0);
+  }
+  #[inline]
+  pub fn add_bounds(&mut self, bounds: &AABB) {
+    self.fbb_.push_slot_always::<&AABB>(Frame::VT_BOUNDS, bounds);
+  }
+  #[inline]
+  pub fn add_obstacles(&mut self, obstacles: ::flatbuffers::WIPOffset<::flatbuffers::Vector<'b , ::flatbuffers::ForwardsUOffset<Obstacle<'b >>>>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(Frame::VT_OBSTACLES, obstacles);
   }
   #[inline]
-  pub fn new(_fbb: &'b mut flatbuffers::FlatBufferBuilder<'a, A>) -> FoodPelletStateBuilder<'a, 'b, A> {
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> FrameBuilder<'a, 'b, A> {
     let start = _fbb.start_table();
-    FoodPelletStateBuilder {
+    FrameBuilder {
       fbb_: _fbb,
       start_: start,
     }
   }
   #[inline]
-  pub fn finish(self) -> flatbuffers::WIPOffset<FoodPelletState<'a>> {
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<Frame<'a>> {
     let o = self.fbb_.end_table(self.start_);
-    self.fbb_.required(o, FoodPelletState::VT_ID,"id");
-    self.fbb_.required(o, FoodPelletState::VT_POS,"pos");
-    flatbuffers::WIPOffset::new(o.value())
+    ::flatbuffers::WIPOffset::new(o.value())
   }
 }
 
-impl core::fmt::Debug for FoodPelletState<'_> {
-  fn fmt(&self, f: &mut core::fmt::Formatter<'_>) -> core::fmt::Result {
-    let mut ds = f.debug_struct("FoodPelletState");
-      ds.field("id", &self.id());
-      ds.field("pos", &self.pos());
+impl ::core::fmt::Debug for Frame<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
+    let mut ds = f.debug_struct("Frame");
+      ds.field("tick", &self.tick());
+      ds.field("timestamp_ns", &self.timestamp_ns());
+      ds.field("entities", &self.entities());
+      ds.field("schema_version", &self.schema_version());
+      ds.field("bounds", &self.bounds());
+      ds.field("obstacles", &self.obstacles());
       ds.finish()
   }
 }
@@ -418,33 +1986,33 @@ pub enum WorldStateUpdateOffset {}
 #[derive(Copy, Clone, PartialEq)]
 
 pub struct WorldStateUpdate<'a> {
-  pub _tab: flatbuffers::Table<'a>,
+  pub _tab: ::flatbuffers::Table<'a>,
 }
 
-impl<'a> flatbuffers::Follow<'a> for WorldStateUpdate<'a> {
+impl<'a> ::flatbuffers::Follow<'a> for WorldStateUpdate<'a> {
   type Inner = WorldStateUpdate<'a>;
   #[inline]
   unsafe fn follow(buf: &'a [u8], loc: usize) -> Self::Inner {
-    Self { _tab: flatbuffers::Table::new(buf, loc) }
+    Self { _tab: unsafe { ::flatbuffers::Table::new(buf, loc) } }
   }
 }
 
 impl<'a> WorldStateUpdate<'a> {
-  pub const VT_TIMESTAMP_NS: flatbuffers::VOffsetT = 4;
-  pub const VT_SIMULATION_ID: flatbuffers::VOffsetT = 6;
-  pub const VT_CURRENT_TICK: flatbuffers::VOffsetT = 8;
-  pub const VT_AGENTS: flatbuffers::VOffsetT = 10;
-  pub const VT_FOOD_PELLETS: flatbuffers::VOffsetT = 12;
+  pub const VT_TIMESTAMP_NS: ::flatbuffers::VOffsetT = 4;
+  pub const VT_SIMULATION_ID: ::flatbuffers::VOffsetT = 6;
+  pub const VT_CURRENT_TICK: ::flatbuffers::VOffsetT = 8;
+  pub const VT_AGENTS: ::flatbuffers::VOffsetT = 10;
+  pub const VT_FOOD_PELLETS: ::flatbuffers::VOffsetT = 12;
 
   #[inline]
-  pub unsafe fn init_from_table(table: flatbuffers::Table<'a>) -> Self {
+  pub unsafe fn init_from_table(table: ::flatbuffers::Table<'a>) -> Self {
     WorldStateUpdate { _tab: table }
   }
   #[allow(unused_mut)]
-  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: flatbuffers::Allocator + 'bldr>(
-    _fbb: &'mut_bldr mut flatbuffers::FlatBufferBuilder<'bldr, A>,
+  pub fn create<'bldr: 'args, 'args: 'mut_bldr, 'mut_bldr, A: ::flatbuffers::Allocator + 'bldr>(
+    _fbb: &'mut_bldr mut ::flatbuffers::FlatBufferBuilder<'bldr, A>,
     args: &'args WorldStateUpdateArgs<'args>
-  ) -> flatbuffers::WIPOffset<WorldStateUpdate<'bldr>> {
+  ) -> ::flatbuffers::WIPOffset<WorldStateUpdate<'bldr>> {
     let mut builder = WorldStateUpdateBuilder::new(_fbb);
     builder.add_current_tick(args.current_tick);
     builder.add_timestamp_ns(args.timestamp_ns);
@@ -467,7 +2035,7 @@ impl<'a> WorldStateUpdate<'a> {
     // Safety:
     // Created from valid Table for this object
     // which contains a valid value in this slot
-    unsafe { self._tab.get::<flatbuffers::ForwardsUOffset<&str>>(WorldStateUpdate::VT_SIMULATION_ID, None).unwrap()}
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<&str>>(WorldStateUpdate::VT_SIMULATION_ID, None).unwrap()}
   }
   #[inline]
   pub fn current_tick(&self) -> u64 {
@@ -477,43 +2045,42 @@ impl<'a> WorldStateUpdate<'a> {
     unsafe { self._tab.get::<u64>(WorldStateUpdate::VT_CURRENT_TICK, Some(0)).unwrap()}
   }
   #[inline]
-  pub fn agents(&self) -> Option<flatbuffers::Vector<'a, flatbuffers::ForwardsUOffset<AgentState<'a>>>> {
+  pub fn agents(&self) -> Option<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<AgentState<'a>>>> {
     // Safety:
     // Created from valid Table for this object
     // which contains a valid value in this slot
-    unsafe { self._tab.get::<flatbuffers::ForwardsUOffset<flatbuffers::Vector<'a, flatbuffers::ForwardsUOffset<AgentState>>>>(WorldStateUpdate::VT_AGENTS, None)}
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<AgentState>>>>(WorldStateUpdate::VT_AGENTS, None)}
   }
   #[inline]
-  pub fn food_pellets(&self) -> Option<flatbuffers::Vector<'a, flatbuffers::ForwardsUOffset<FoodPelletState<'a>>>> {
+  pub fn food_pellets(&self) -> Option<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<FoodPelletState<'a>>>> {
     // Safety:
     // Created from valid Table for this object
     // which contains a valid value in this slot
-    unsafe { self._tab.get::<flatbuffers::ForwardsUOffset<flatbuffers::Vector<'a, flatbuffers::ForwardsUOffset<FoodPelletState>>>>(WorldStateUpdate::VT_FOOD_PELLETS, None)}
+    unsafe { self._tab.get::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<FoodPelletState>>>>(WorldStateUpdate::VT_FOOD_PELLETS, None)}
   }
 }
 
-impl flatbuffers::Verifiable for WorldStateUpdate<'_> {
+impl ::flatbuffers::Verifiable for WorldStateUpdate<'_> {
   #[inline]
   fn run_verifier(
-    v: &mut flatbuffers::Verifier, pos: usize
-  ) -> Result<(), flatbuffers::InvalidFlatbuffer> {
-    use self::flatbuffers::Verifiable;
+    v: &mut ::flatbuffers::Verifier, pos: usize
+  ) -> Result<(), ::flatbuffers::InvalidFlatbuffer> {
     v.visit_table(pos)?
      .visit_field::<i64>("timestamp_ns", Self::VT_TIMESTAMP_NS, false)?
-     .visit_field::<flatbuffers::ForwardsUOffset<&str>>("simulation_id", Self::VT_SIMULATION_ID, true)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<&str>>("simulation_id", Self::VT_SIMULATION_ID, true)?
      .visit_field::<u64>("current_tick", Self::VT_CURRENT_TICK, false)?
-     .visit_field::<flatbuffers::ForwardsUOffset<flatbuffers::Vector<'_, flatbuffers::ForwardsUOffset<AgentState>>>>("agents", Self::VT_AGENTS, false)?
-     .visit_field::<flatbuffers::ForwardsUOffset<flatbuffers::Vector<'_, flatbuffers::ForwardsUOffset<FoodPelletState>>>>("food_pellets", Self::VT_FOOD_PELLETS, false)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'_, ::flatbuffers::ForwardsUOffset<AgentState>>>>("agents", Self::VT_AGENTS, false)?
+     .visit_field::<::flatbuffers::ForwardsUOffset<::flatbuffers::Vector<'_, ::flatbuffers::ForwardsUOffset<FoodPelletState>>>>("food_pellets", Self::VT_FOOD_PELLETS, false)?
      .finish();
     Ok(())
   }
 }
 pub struct WorldStateUpdateArgs<'a> {
     pub timestamp_ns: i64,
-    pub simulation_id: Option<flatbuffers::WIPOffset<&'a str>>,
+    pub simulation_id: Option<::flatbuffers::WIPOffset<&'a str>>,
     pub current_tick: u64,
-    pub agents: Option<flatbuffers::WIPOffset<flatbuffers::Vector<'a, flatbuffers::ForwardsUOffset<AgentState<'a>>>>>,
-    pub food_pellets: Option<flatbuffers::WIPOffset<flatbuffers::Vector<'a, flatbuffers::ForwardsUOffset<FoodPelletState<'a>>>>>,
+    pub agents: Option<::flatbuffers::WIPOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<AgentState<'a>>>>>,
+    pub food_pellets: Option<::flatbuffers::WIPOffset<::flatbuffers::Vector<'a, ::flatbuffers::ForwardsUOffset<FoodPelletState<'a>>>>>,
 }
 impl<'a> Default for WorldStateUpdateArgs<'a> {
   #[inline]
@@ -528,33 +2095,33 @@ impl<'a> Default for WorldStateUpdateArgs<'a> {
   }
 }
 
-pub struct WorldStateUpdateBuilder<'a: 'b, 'b, A: flatbuffers::Allocator + 'a> {
-  fbb_: &'b mut flatbuffers::FlatBufferBuilder<'a, A>,
-  start_: flatbuffers::WIPOffset<flatbuffers::TableUnfinishedWIPOffset>,
+pub struct WorldStateUpdateBuilder<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> {
+  fbb_: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+  start_: ::flatbuffers::WIPOffset<::flatbuffers::TableUnfinishedWIPOffset>,
 }
-impl<'a: 'b, 'b, A: flatbuffers::Allocator + 'a> WorldStateUpdateBuilder<'a, 'b, A> {
+impl<'a: 'b, 'b, A: ::flatbuffers::Allocator + 'a> WorldStateUpdateBuilder<'a, 'b, A> {
   #[inline]
   pub fn add_timestamp_ns(&mut self, timestamp_ns: i64) {
     self.fbb_.push_slot::<i64>(WorldStateUpdate::VT_TIMESTAMP_NS, timestamp_ns, 0);
   }
   #[inline]
-  pub fn add_simulation_id(&mut self, simulation_id: flatbuffers::WIPOffset<&'b  str>) {
-    self.fbb_.push_slot_always::<flatbuffers::WIPOffset<_>>(WorldStateUpdate::VT_SIMULATION_ID, simulation_id);
+  pub fn add_simulation_id(&mut self, simulation_id: ::flatbuffers::WIPOffset<&'b  str>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(WorldStateUpdate::VT_SIMULATION_ID, simulation_id);
   }
   #[inline]
   pub fn add_current_tick(&mut self, current_tick: u64) {
     self.fbb_.push_slot::<u64>(WorldStateUpdate::VT_CURRENT_TICK, current_tick, 0);
   }
   #[inline]
-  pub fn add_agents(&mut self, agents: flatbuffers::WIPOffset<flatbuffers::Vector<'b , flatbuffers::ForwardsUOffset<AgentState<'b >>>>) {
-    self.fbb_.push_slot_always::<flatbuffers::WIPOffset<_>>(WorldStateUpdate::VT_AGENTS, agents);
+  pub fn add_agents(&mut self, agents: ::flatbuffers::WIPOffset<::flatbuffers::Vector<'b , ::flatbuffers::ForwardsUOffset<AgentState<'b >>>>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(WorldStateUpdate::VT_AGENTS, agents);
   }
   #[inline]
-  pub fn add_food_pellets(&mut self, food_pellets: flatbuffers::WIPOffset<flatbuffers::Vector<'b , flatbuffers::ForwardsUOffset<FoodPelletState<'b >>>>) {
-    self.fbb_.push_slot_always::<flatbuffers::WIPOffset<_>>(WorldStateUpdate::VT_FOOD_PELLETS, food_pellets);
+  pub fn add_food_pellets(&mut self, food_pellets: ::flatbuffers::WIPOffset<::flatbuffers::Vector<'b , ::flatbuffers::ForwardsUOffset<FoodPelletState<'b >>>>) {
+    self.fbb_.push_slot_always::<::flatbuffers::WIPOffset<_>>(WorldStateUpdate::VT_FOOD_PELLETS, food_pellets);
   }
   #[inline]
-  pub fn new(_fbb: &'b mut flatbuffers::FlatBufferBuilder<'a, A>) -> WorldStateUpdateBuilder<'a, 'b, A> {
+  pub fn new(_fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>) -> WorldStateUpdateBuilder<'a, 'b, A> {
     let start = _fbb.start_table();
     WorldStateUpdateBuilder {
       fbb_: _fbb,
@@ -562,15 +2129,15 @@ impl<'a: 'b, 'b, A: flatbuffers::Allocator + 'a> WorldStateUpdateBuilder<'a, 'b,
     }
   }
   #[inline]
-  pub fn finish(self) -> flatbuffers::WIPOffset<WorldStateUpdate<'a>> {
+  pub fn finish(self) -> ::flatbuffers::WIPOffset<WorldStateUpdate<'a>> {
     let o = self.fbb_.end_table(self.start_);
     self.fbb_.required(o, WorldStateUpdate::VT_SIMULATION_ID,"simulation_id");
-    flatbuffers::WIPOffset::new(o.value())
+    ::flatbuffers::WIPOffset::new(o.value())
   }
 }
 
-impl core::fmt::Debug for WorldStateUpdate<'_> {
-  fn fmt(&self, f: &mut core::fmt::Formatter<'_>) -> core::fmt::Result {
+impl ::core::fmt::Debug for WorldStateUpdate<'_> {
+  fn fmt(&self, f: &mut ::core::fmt::Formatter<'_>) -> ::core::fmt::Result {
     let mut ds = f.debug_struct("WorldStateUpdate");
       ds.field("timestamp_ns", &self.timestamp_ns());
       ds.field("simulation_id", &self.simulation_id());
@@ -587,8 +2154,8 @@ impl core::fmt::Debug for WorldStateUpdate<'_> {
 /// catch every error, or be maximally performant. For the
 /// previous, unchecked, behavior use
 /// `root_as_world_state_update_unchecked`.
-pub fn root_as_world_state_update(buf: &[u8]) -> Result<WorldStateUpdate, flatbuffers::InvalidFlatbuffer> {
-  flatbuffers::root::<WorldStateUpdate>(buf)
+pub fn root_as_world_state_update(buf: &[u8]) -> Result<WorldStateUpdate<'_>, ::flatbuffers::InvalidFlatbuffer> {
+  ::flatbuffers::root::<WorldStateUpdate>(buf)
 }
 #[inline]
 /// Verifies that a buffer of bytes contains a size prefixed
@@ -597,8 +2164,8 @@ pub fn root_as_world_state_update(buf: &[u8]) -> Result<WorldStateUpdate, flatbu
 /// catch every error, or be maximally performant. For the
 /// previous, unchecked, behavior use
 /// `size_prefixed_root_as_world_state_update_unchecked`.
-pub fn size_prefixed_root_as_world_state_update(buf: &[u8]) -> Result<WorldStateUpdate, flatbuffers::InvalidFlatbuffer> {
-  flatbuffers::size_prefixed_root::<WorldStateUpdate>(buf)
+pub fn size_prefixed_root_as_world_state_update(buf: &[u8]) -> Result<WorldStateUpdate<'_>, ::flatbuffers::InvalidFlatbuffer> {
+  ::flatbuffers::size_prefixed_root::<WorldStateUpdate>(buf)
 }
 #[inline]
 /// Verifies, with the given options, that a buffer of bytes
@@ -608,10 +2175,10 @@ pub fn size_prefixed_root_as_world_state_update(buf: &[u8]) -> Result<WorldState
 /// previous, unchecked, behavior use
 /// `root_as_world_state_update_unchecked`.
 pub fn root_as_world_state_update_with_opts<'b, 'o>(
-  opts: &'o flatbuffers::VerifierOptions,
+  opts: &'o ::flatbuffers::VerifierOptions,
   buf: &'b [u8],
-) -> Result<WorldStateUpdate<'b>, flatbuffers::InvalidFlatbuffer> {
-  flatbuffers::root_with_opts::<WorldStateUpdate<'b>>(opts, buf)
+) -> Result<WorldStateUpdate<'b>, ::flatbuffers::InvalidFlatbuffer> {
+  ::flatbuffers::root_with_opts::<WorldStateUpdate<'b>>(opts, buf)
 }
 #[inline]
 /// Verifies, with the given verifier options, that a buffer of
@@ -621,34 +2188,34 @@ pub fn root_as_world_state_update_with_opts<'b, 'o>(
 /// previous, unchecked, behavior use
 /// `root_as_world_state_update_unchecked`.
 pub fn size_prefixed_root_as_world_state_update_with_opts<'b, 'o>(
-  opts: &'o flatbuffers::VerifierOptions,
+  opts: &'o ::flatbuffers::VerifierOptions,
   buf: &'b [u8],
-) -> Result<WorldStateUpdate<'b>, flatbuffers::InvalidFlatbuffer> {
-  flatbuffers::size_prefixed_root_with_opts::<WorldStateUpdate<'b>>(opts, buf)
+) -> Result<WorldStateUpdate<'b>, ::flatbuffers::InvalidFlatbuffer> {
+  ::flatbuffers::size_prefixed_root_with_opts::<WorldStateUpdate<'b>>(opts, buf)
 }
 #[inline]
 /// Assumes, without verification, that a buffer of bytes contains a WorldStateUpdate and returns it.
 /// # Safety
 /// Callers must trust the given bytes do indeed contain a valid `WorldStateUpdate`.
-pub unsafe fn root_as_world_state_update_unchecked(buf: &[u8]) -> WorldStateUpdate {
-  flatbuffers::root_unchecked::<WorldStateUpdate>(buf)
+pub unsafe fn root_as_world_state_update_unchecked(buf: &[u8]) -> WorldStateUpdate<'_> {
+  unsafe { ::flatbuffers::root_unchecked::<WorldStateUpdate>(buf) }
 }
 #[inline]
 /// Assumes, without verification, that a buffer of bytes contains a size prefixed WorldStateUpdate and returns it.
 /// # Safety
 /// Callers must trust the given bytes do indeed contain a valid size prefixed `WorldStateUpdate`.
-pub unsafe fn size_prefixed_root_as_world_state_update_unchecked(buf: &[u8]) -> WorldStateUpdate {
-  flatbuffers::size_prefixed_root_unchecked::<WorldStateUpdate>(buf)
+pub unsafe fn size_prefixed_root_as_world_state_update_unchecked(buf: &[u8]) -> WorldStateUpdate<'_> {
+  unsafe { ::flatbuffers::size_prefixed_root_unchecked::<WorldStateUpdate>(buf) }
 }
 #[inline]
-pub fn finish_world_state_update_buffer<'a, 'b, A: flatbuffers::Allocator + 'a>(
-    fbb: &'b mut flatbuffers::FlatBufferBuilder<'a, A>,
-    root: flatbuffers::WIPOffset<WorldStateUpdate<'a>>) {
+pub fn finish_world_state_update_buffer<'a, 'b, A: ::flatbuffers::Allocator + 'a>(
+    fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>,
+    root: ::flatbuffers::WIPOffset<WorldStateUpdate<'a>>) {
   fbb.finish(root, None);
 }
 
 #[inline]
-pub fn finish_size_prefixed_world_state_update_buffer<'a, 'b, A: flatbuffers::Allocator + 'a>(fbb: &'b mut flatbuffers::FlatBufferBuilder<'a, A>, root: flatbuffers::WIPOffset<WorldStateUpdate<'a>>) {
+pub fn finish_size_prefixed_world_state_update_buffer<'a, 'b, A: ::flatbuffers::Allocator + 'a>(fbb: &'b mut ::flatbuffers::FlatBufferBuilder<'a, A>, root: ::flatbuffers::WIPOffset<WorldStateUpdate<'a>>) {
   fbb.finish_size_prefixed(root, None);
 }
 }  // pub mod state